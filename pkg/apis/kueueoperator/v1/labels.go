@@ -0,0 +1,11 @@
+package v1
+
+const (
+	// ManagedNamespaceLabel opts a namespace in to Kueue management when set to "true".
+	ManagedNamespaceLabel = "kueue.openshift.io/managed"
+	// ManagedByLabel marks the objects created by the operator. Queues without it were
+	// created by users and are never modified by the operator.
+	ManagedByLabel = "kueue.openshift.io/managed-by"
+	// ManagedByValue is the value of ManagedByLabel on the objects applied by the operator.
+	ManagedByValue = "kueue-operator"
+)
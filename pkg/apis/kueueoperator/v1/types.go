@@ -2,6 +2,7 @@ package v1
 
 import (
 	operatorv1 "github.com/openshift/api/operator/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -15,6 +16,14 @@ import (
 // +genclient:nonNamespaced
 // +kubebuilder:storageversion
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Available",type=string,JSONPath=`.status.conditions[?(@.type=="Available")].status`
+// +kubebuilder:printcolumn:name="Progressing",type=string,JSONPath=`.status.conditions[?(@.type=="Progressing")].status`
+// +kubebuilder:printcolumn:name="Degraded",type=string,JSONPath=`.status.conditions[?(@.type=="Degraded")].status`
+// +kubebuilder:printcolumn:name="Kueue Version",type=string,JSONPath=`.status.kueueVersion`
+// +kubebuilder:printcolumn:name="Label Policy",type=string,JSONPath=`.status.effectiveConfiguration.labelPolicy`,priority=1
+// +kubebuilder:printcolumn:name="Gang Scheduling",type=string,JSONPath=`.status.effectiveConfiguration.gangScheduling`,priority=1
+// +kubebuilder:printcolumn:name="Preemption",type=string,JSONPath=`.status.effectiveConfiguration.preemptionPolicy`,priority=1
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 // +kubebuilder:validation:XValidation:rule="self.metadata.name == 'cluster'",message="Kueue is a singleton, .metadata.name must be 'cluster'"
 type Kueue struct {
 	metav1.TypeMeta `json:",inline"`
@@ -37,6 +46,689 @@ type KueueOperandSpec struct {
 	// for the Kueue operator.
 	// +required
 	Config KueueConfiguration `json:"config,omitzero"`
+	// queues declares the ResourceFlavors, Cohorts and ClusterQueues the operator
+	// creates for Kueue, and the LocalQueues it creates in every namespace labeled
+	// with kueue.openshift.io/managed=true.
+	// The operator labels the objects it creates with
+	// kueue.openshift.io/managed-by=kueue-operator, keeps them in sync with this
+	// section, and deletes them when they are removed from it.
+	// Objects with the same name that were not created by the operator are left
+	// unchanged and reported in the QueuesInSync condition.
+	// queues is optional.
+	// +optional
+	Queues Queues `json:"queues,omitzero"`
+	// namespaceProtection restricts who may add, change or remove the namespace labels
+	// that opt namespaces in or out of Kueue management, and prevents their removal
+	// while the namespace has admitted workloads.
+	// It is enforced with a ValidatingAdmissionPolicy on namespace updates.
+	// namespaceProtection is optional.
+	// +optional
+	NamespaceProtection NamespaceProtection `json:"namespaceProtection,omitzero"`
+	// containerDefaults makes the operator create a LimitRange setting the default
+	// requests and limits of containers in every namespace labeled with
+	// kueue.openshift.io/managed=true, so that Kueue accounts for the pods created
+	// without requests.
+	// No LimitRange is created in the namespaces where a LimitRange not created by the
+	// operator sets defaults for the same resources, or bounds them so that the
+	// defaults would be rejected. These namespaces are reported in the
+	// LimitRangesReady condition.
+	// The LimitRange is deleted when the namespace is no longer labeled.
+	// containerDefaults is optional.
+	// +optional
+	ContainerDefaults ContainerDefaults `json:"containerDefaults,omitzero"`
+	// uninstallPolicy controls what happens to the workloads managed by Kueue when
+	// this Kueue CR is deleted.
+	// The allowed values are Orphan, ReleaseAll and Block.
+	// Orphan removes Kueue and leaves the workloads as they are: pods gated by Kueue
+	// and jobs suspended by Kueue stay pending until Kueue is installed again.
+	// ReleaseAll stops Kueue, then removes the kueue.x-k8s.io/admission scheduling gate
+	// from pods and unsuspends the jobs of the enabled integrations that were not
+	// admitted, before the CR is removed. Released workloads start without quota.
+	// Block keeps the CR, and Kueue, until no workload is waiting for admission. The
+	// pending workloads are reported in the UninstallReady condition.
+	// When set to "", this means no opinion and the operator will choose a reasonable default.
+	// The current default is Orphan.
+	// +optional
+	UninstallPolicy UninstallPolicy `json:"uninstallPolicy,omitempty"`
+	// removalPolicy controls whether the Kueue CRDs, and the Kueue custom resources such
+	// as ClusterQueues, LocalQueues and Workloads, are kept when this Kueue CR is deleted.
+	// The allowed values are Retain and Delete.
+	// Retain keeps them, so that Kueue can be installed again with its queues.
+	// Delete deletes the Kueue custom resources, removing their finalizers, and then the
+	// Kueue CRDs. Use uninstallExport to keep a copy of them.
+	// When set to "", this means no opinion and the operator will choose a reasonable default.
+	// The current default is Retain.
+	// +optional
+	RemovalPolicy RemovalPolicy `json:"removalPolicy,omitempty"`
+	// uninstallExport exports the Kueue custom resources to ConfigMaps when this Kueue CR
+	// is deleted, before anything is deleted. Exporting to a PersistentVolumeClaim is
+	// not supported.
+	// When omitted, no export is taken.
+	// +optional
+	UninstallExport UninstallExport `json:"uninstallExport,omitzero"`
+	// monitoring configures the PrometheusRule the operator creates for Kueue when the
+	// PrometheusRule CRD is installed. It holds alerts on the availability of Kueue,
+	// the workloads which stay inadmissible, the latency of the admission cycles, the
+	// errors of the webhooks, the expiry of the certificates and the inactive
+	// ClusterQueues, and recording rules of the utilization of the ClusterQueues.
+	// When omitted, all the rules are created with their default thresholds.
+	// +optional
+	Monitoring Monitoring `json:"monitoring,omitzero"`
+}
+
+// Monitoring configures the alerting and recording rules of Kueue.
+// +kubebuilder:validation:MinProperties=1
+type Monitoring struct {
+	// alerts adjusts or disables the alerts of the PrometheusRule.
+	// The alerts that are not listed are created with their default threshold.
+	// alerts, if specified, must have at least one item and no more than 6 items.
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=6
+	// +optional
+	Alerts []AlertConfiguration `json:"alerts,omitempty"`
+	// recordingRules controls the recording rules of the utilization of the quotas of
+	// the ClusterQueues and of their pending workloads.
+	// The allowed values are Enabled, Disabled and "".
+	// When set to "", this means no opinion and the operator will choose a reasonable default.
+	// The current default is Enabled.
+	// +optional
+	RecordingRules RuleState `json:"recordingRules,omitempty"`
+}
+
+// RuleState enables or disables rules of the PrometheusRule.
+// +kubebuilder:validation:Enum="";Enabled;Disabled
+type RuleState string
+
+const (
+	RuleStateEnabled  RuleState = "Enabled"
+	RuleStateDisabled RuleState = "Disabled"
+)
+
+// KueueAlert is an alert of the PrometheusRule.
+// +kubebuilder:validation:Enum=KueueOperandUnavailable;KueueWorkloadsPendingTooLong;KueueAdmissionCycleSlow;KueueWebhookErrors;KueueCertificateExpiring;KueueClusterQueueInactive
+type KueueAlert string
+
+const (
+	// KueueOperandUnavailable fires when no replica of Kueue is available.
+	KueueOperandUnavailable KueueAlert = "KueueOperandUnavailable"
+	// KueueWorkloadsPendingTooLong fires when a ClusterQueue has inadmissible pending
+	// workloads for too long.
+	KueueWorkloadsPendingTooLong KueueAlert = "KueueWorkloadsPendingTooLong"
+	// KueueAdmissionCycleSlow fires when the admission attempts take too long.
+	KueueAdmissionCycleSlow KueueAlert = "KueueAdmissionCycleSlow"
+	// KueueWebhookErrors fires when too many admission requests fail.
+	KueueWebhookErrors KueueAlert = "KueueWebhookErrors"
+	// KueueCertificateExpiring fires when a certificate of Kueue is about to expire.
+	KueueCertificateExpiring KueueAlert = "KueueCertificateExpiring"
+	// KueueClusterQueueInactive fires when a ClusterQueue cannot admit workloads.
+	KueueClusterQueueInactive KueueAlert = "KueueClusterQueueInactive"
+)
+
+// AlertConfiguration adjusts an alert of the PrometheusRule.
+// +kubebuilder:validation:XValidation:rule="!has(self.threshold) || !(self.name in ['KueueOperandUnavailable', 'KueueClusterQueueInactive'])",message="threshold is not supported by KueueOperandUnavailable and KueueClusterQueueInactive"
+type AlertConfiguration struct {
+	// name is the alert.
+	// The allowed values are KueueOperandUnavailable, KueueWorkloadsPendingTooLong,
+	// KueueAdmissionCycleSlow, KueueWebhookErrors, KueueCertificateExpiring and
+	// KueueClusterQueueInactive.
+	// +required
+	Name KueueAlert `json:"name"`
+	// state enables or disables the alert.
+	// The allowed values are Enabled, Disabled and "".
+	// When set to "", this means no opinion and the operator will choose a reasonable default.
+	// The current default is Enabled.
+	// +optional
+	State RuleState `json:"state,omitempty"`
+	// threshold is the value the alert fires at, in the unit of the alert:
+	// for KueueWorkloadsPendingTooLong, the time a ClusterQueue has inadmissible pending
+	// workloads before the alert fires, in seconds, defaulting to 3600;
+	// for KueueAdmissionCycleSlow, the 99th percentile of the duration of the
+	// admission attempts, in seconds, defaulting to 1;
+	// for KueueWebhookErrors, the percentage of the admission requests failing,
+	// defaulting to 5;
+	// for KueueCertificateExpiring, the time left before a certificate expires, in
+	// seconds, defaulting to 604800, 7 days.
+	// KueueOperandUnavailable and KueueClusterQueueInactive have no threshold.
+	// threshold must be greater than 0.
+	// +kubebuilder:validation:XValidation:rule="quantity(string(self)).isGreaterThan(quantity('0'))",message="threshold must be greater than 0"
+	// +optional
+	Threshold *resource.Quantity `json:"threshold,omitempty"`
+}
+
+// RemovalPolicy controls whether the Kueue CRDs and custom resources are deleted when
+// Kueue is uninstalled.
+// +kubebuilder:validation:Enum="";Retain;Delete
+type RemovalPolicy string
+
+const (
+	// RemovalPolicyRetain keeps the Kueue CRDs and custom resources.
+	RemovalPolicyRetain RemovalPolicy = "Retain"
+	// RemovalPolicyDelete deletes the Kueue CRDs and custom resources.
+	RemovalPolicyDelete RemovalPolicy = "Delete"
+)
+
+// UninstallExport describes where the Kueue custom resources are exported when Kueue is
+// uninstalled. The export is only written to ConfigMaps, exporting to a
+// PersistentVolumeClaim is not supported.
+// +kubebuilder:validation:MinProperties=1
+type UninstallExport struct {
+	// configMap exports the Kueue custom resources to ConfigMaps.
+	// +required
+	ConfigMap ConfigMapExport `json:"configMap,omitzero"`
+}
+
+// ConfigMapExport exports the Kueue custom resources to ConfigMaps.
+// The resources are written as YAML documents to the ConfigMaps named after name,
+// suffixed with their index, and labeled with kueue.openshift.io/export=<name>.
+// The ConfigMap named name lists them, and is written last, once the export is complete.
+// ConfigMaps are limited to 1MiB, so the export is split over as many ConfigMaps as needed.
+type ConfigMapExport struct {
+	// namespace is the namespace of the ConfigMaps.
+	// It should not be the namespace of the operator, which may be deleted with it.
+	// Must be a valid DNS 1123 label consisting of lower-case alphanumeric characters
+	// and hyphens, of at most 63 characters in length.
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self.matches(r'^[a-z0-9]([-a-z0-9]*[a-z0-9])?$')",message="namespace must be a valid DNS 1123 label"
+	// +required
+	Namespace string `json:"namespace,omitempty"`
+	// name is the name of the ConfigMap listing the export.
+	// Must be a valid DNS 1123 label consisting of lower-case alphanumeric characters
+	// and hyphens, of at most 56 characters in length, leaving room for the index
+	// suffix of the other ConfigMaps.
+	// +kubebuilder:validation:MaxLength=56
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self.matches(r'^[a-z0-9]([-a-z0-9]*[a-z0-9])?$')",message="name must be a valid DNS 1123 label"
+	// +required
+	Name string `json:"name,omitempty"`
+}
+
+// UninstallPolicy controls what happens to the workloads managed by Kueue when Kueue is
+// uninstalled.
+// +kubebuilder:validation:Enum="";Orphan;ReleaseAll;Block
+type UninstallPolicy string
+
+const (
+	// UninstallPolicyOrphan leaves the workloads waiting for admission as they are.
+	UninstallPolicyOrphan UninstallPolicy = "Orphan"
+	// UninstallPolicyReleaseAll releases the workloads waiting for admission.
+	UninstallPolicyReleaseAll UninstallPolicy = "ReleaseAll"
+	// UninstallPolicyBlock holds the uninstallation while workloads wait for admission.
+	UninstallPolicyBlock UninstallPolicy = "Block"
+)
+
+// ContainerDefaults are the default requests and limits of the containers of managed
+// namespaces.
+type ContainerDefaults struct {
+	// resources are the defaults of each resource.
+	// resources must have at least one item and no more than 16 items.
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +required
+	Resources []ContainerResourceDefault `json:"resources,omitempty"`
+}
+
+// ContainerResourceDefault is the default request and limit of a resource.
+// +kubebuilder:validation:XValidation:rule="has(self.defaultRequest) || has(self.defaultLimit)",message="at least one of defaultRequest and defaultLimit must be set"
+type ContainerResourceDefault struct {
+	// name is the name of the resource, e.g. cpu, memory or nvidia.com/gpu.
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Name string `json:"name"`
+	// defaultRequest is the request set on the containers that do not request the
+	// resource. It must not be greater than defaultLimit.
+	// When omitted, Kubernetes uses defaultLimit, if set.
+	// +optional
+	DefaultRequest *resource.Quantity `json:"defaultRequest,omitempty"`
+	// defaultLimit is the limit set on the containers that do not limit the resource.
+	// When omitted, containers are not limited by default.
+	// +optional
+	DefaultLimit *resource.Quantity `json:"defaultLimit,omitempty"`
+}
+
+// LabelRemovalPolicy controls whether the protected labels may be removed from a
+// namespace with admitted workloads.
+// +kubebuilder:validation:Enum="";Allow;BlockWhileAdmitted
+type LabelRemovalPolicy string
+
+const (
+	// LabelRemovalPolicyAllow allows the members of the allowed groups to remove the
+	// protected labels at any time.
+	LabelRemovalPolicyAllow LabelRemovalPolicy = "Allow"
+	// LabelRemovalPolicyBlockWhileAdmitted prevents the removal of the protected labels
+	// while workloads are admitted in the namespace.
+	LabelRemovalPolicyBlockWhileAdmitted LabelRemovalPolicy = "BlockWhileAdmitted"
+)
+
+// NamespaceProtection restricts the changes to the labels opting namespaces in Kueue
+// management.
+type NamespaceProtection struct {
+	// allowedGroups are the groups whose members may add, change or remove the
+	// protected labels. Members of system:masters and the operator are always allowed.
+	// allowedGroups must have at least one item and no more than 16 items.
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=253
+	// +required
+	AllowedGroups []string `json:"allowedGroups,omitempty"`
+	// labelKeys are the protected namespace labels.
+	// When omitted, only kueue.openshift.io/managed is protected.
+	// labelKeys, if specified, can not have more than 8 items.
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=8
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=317
+	// +kubebuilder:validation:items:XValidation:rule="self.matches(r'^([a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*/)?[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$')",message="labelKeys must be valid label keys"
+	// +optional
+	LabelKeys []string `json:"labelKeys,omitempty"`
+	// labelRemovalPolicy controls whether the protected labels may be removed, or
+	// changed, while the namespace has admitted workloads.
+	// The allowed values are Allow and BlockWhileAdmitted.
+	// Allow lets the members of allowedGroups remove the labels at any time.
+	// BlockWhileAdmitted rejects their removal while a LocalQueue of the namespace has
+	// admitted workloads. The operator tracks them in the
+	// kueue.openshift.io/admitted-workloads namespace annotation, which is refreshed
+	// every minute and may only be changed by the operator.
+	// When set to "", this means no opinion and the operator will choose a reasonable default.
+	// The current default is BlockWhileAdmitted.
+	// +optional
+	LabelRemovalPolicy LabelRemovalPolicy `json:"labelRemovalPolicy,omitempty"`
+}
+
+// Queues declares the queues created by the operator.
+// +kubebuilder:validation:MinProperties=1
+type Queues struct {
+	// resourceFlavors are the ResourceFlavors to create.
+	// resourceFlavors, if specified, can not have more than 16 items.
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	ResourceFlavors []ResourceFlavorTemplate `json:"resourceFlavors,omitempty"`
+	// cohorts are the Cohorts to create.
+	// cohorts, if specified, can not have more than 16 items.
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	Cohorts []CohortTemplate `json:"cohorts,omitempty"`
+	// clusterQueues are the ClusterQueues to create.
+	// The ClusterQueues admit workloads from the namespaces labeled with
+	// kueue.openshift.io/managed=true.
+	// clusterQueues, if specified, can not have more than 32 items.
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=32
+	// +optional
+	ClusterQueues []ClusterQueueTemplate `json:"clusterQueues,omitempty"`
+	// localQueues are LocalQueue templates. A LocalQueue is created from each
+	// template in every namespace labeled with kueue.openshift.io/managed=true.
+	// localQueues, if specified, can not have more than 8 items.
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=8
+	// +optional
+	LocalQueues []LocalQueueTemplate `json:"localQueues,omitempty"`
+	// namespaceOnboarding configures the LocalQueue named default that the operator
+	// creates in every namespace labeled with kueue.openshift.io/managed=true, so that
+	// workloads submitted without a queue-name label are admitted.
+	// The ClusterQueue of the default LocalQueue is the one named by the
+	// kueue.openshift.io/cluster-queue annotation of the namespace, or else the one of
+	// the first clusterQueueMappings entry matching the namespace labels, or else
+	// defaultClusterQueue. No default LocalQueue is created in namespaces where none
+	// of them selects a ClusterQueue, or where a LocalQueue named default already exists.
+	// The default LocalQueue is deleted when the namespace is no longer labeled.
+	// namespaceOnboarding is optional.
+	// +optional
+	NamespaceOnboarding NamespaceOnboarding `json:"namespaceOnboarding,omitzero"`
+	// assignmentPolicy sets the kueue.x-k8s.io/queue-name and
+	// kueue.x-k8s.io/priority-class labels of the workloads created for the enabled
+	// integrations and external frameworks, based on the namespace labels, the groups
+	// of the requesting user and the workload labels.
+	// The policy is enforced with a MutatingAdmissionPolicy when the
+	// admissionregistration.k8s.io/v1beta1 MutatingAdmissionPolicy API is served,
+	// and otherwise with a webhook served by the operator.
+	// Only workloads without a controller owner reference are labeled, workloads
+	// created by other workloads are managed through their owner.
+	// assignmentPolicy is optional.
+	// +optional
+	AssignmentPolicy QueueAssignmentPolicy `json:"assignmentPolicy,omitzero"`
+	// tenantGuardrails restrict the LocalQueues and WorkloadPriorityClasses the
+	// workloads of the enabled integrations and external frameworks may use, based on
+	// the namespace labels and the groups of the requesting user.
+	// The guardrails are enforced with a ValidatingAdmissionPolicy.
+	// tenantGuardrails is optional.
+	// +optional
+	TenantGuardrails TenantGuardrails `json:"tenantGuardrails,omitzero"`
+}
+
+// TenantGuardrails restrict the LocalQueues and priority classes workloads may use.
+// +kubebuilder:validation:MinProperties=1
+type TenantGuardrails struct {
+	// rules grant the use of LocalQueues and priority classes.
+	// A workload may use a LocalQueue, or a priority class, allowed by any of the rules
+	// that match it. When no matching rule restricts the LocalQueues, or the priority
+	// classes, any of them may be used.
+	// Workloads without the kueue.x-k8s.io/queue-name, or the
+	// kueue.x-k8s.io/priority-class label, are not restricted.
+	// rules must have at least one item and no more than 32 items.
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	// +required
+	Rules []TenantGuardrailRule `json:"rules,omitempty"`
+}
+
+// TenantGuardrailRule allows the workloads it matches to use a set of LocalQueues and
+// priority classes.
+// A rule matches a workload when both namespaceLabels and groups match. A rule without
+// any of them matches every workload.
+// +kubebuilder:validation:XValidation:rule="has(self.allowedQueueNames) || has(self.allowedPriorityClasses)",message="at least one of allowedQueueNames and allowedPriorityClasses must be set"
+type TenantGuardrailRule struct {
+	// name identifies the rule.
+	// Must be a valid DNS 1123 label consisting of lower-case alphanumeric characters
+	// and hyphens, of at most 63 characters in length.
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self.matches(r'^[a-z0-9]([-a-z0-9]*[a-z0-9])?$')",message="name must be a valid DNS 1123 label"
+	// +required
+	Name string `json:"name"`
+	// namespaceLabels are the labels the namespace of the workload must have.
+	// namespaceLabels, if specified, can not have more than 8 entries.
+	// +kubebuilder:validation:MaxProperties=8
+	// +optional
+	NamespaceLabels map[string]string `json:"namespaceLabels,omitempty"`
+	// groups match workloads created or updated by a user that belongs to at least
+	// one of them.
+	// groups, if specified, can not have more than 16 items.
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=253
+	// +optional
+	Groups []string `json:"groups,omitempty"`
+	// allowedQueueNames are the LocalQueues the matching workloads may use.
+	// When omitted, the rule does not restrict the LocalQueues.
+	// allowedQueueNames, if specified, can not have more than 64 items.
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	// +kubebuilder:validation:items:MaxLength=253
+	// +kubebuilder:validation:items:XValidation:rule="self.matches(r'^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$')",message="allowedQueueNames must be valid DNS 1123 subdomains"
+	// +optional
+	AllowedQueueNames []string `json:"allowedQueueNames,omitempty"`
+	// allowedPriorityClasses are the WorkloadPriorityClasses the matching workloads
+	// may use.
+	// When omitted, the rule does not restrict the priority classes.
+	// allowedPriorityClasses, if specified, can not have more than 64 items.
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	// +kubebuilder:validation:items:MaxLength=253
+	// +kubebuilder:validation:items:XValidation:rule="self.matches(r'^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$')",message="allowedPriorityClasses must be valid DNS 1123 subdomains"
+	// +optional
+	AllowedPriorityClasses []string `json:"allowedPriorityClasses,omitempty"`
+}
+
+// QueueAssignmentPolicy assigns workloads to LocalQueues and priority classes.
+// +kubebuilder:validation:MinProperties=1
+type QueueAssignmentPolicy struct {
+	// rules are evaluated in order when a workload is created. For each label, the
+	// first rule that matches the workload and sets the label is used.
+	// rules must have at least one item and no more than 32 items.
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	// +required
+	Rules []QueueAssignmentRule `json:"rules,omitempty"`
+}
+
+// QueueAssignmentMode controls whether a rule replaces the labels set by users.
+// +kubebuilder:validation:Enum="";SetIfAbsent;Overwrite
+type QueueAssignmentMode string
+
+const (
+	// QueueAssignmentModeSetIfAbsent only sets the labels workloads are created without.
+	QueueAssignmentModeSetIfAbsent QueueAssignmentMode = "SetIfAbsent"
+	// QueueAssignmentModeOverwrite replaces the labels set by users.
+	QueueAssignmentModeOverwrite QueueAssignmentMode = "Overwrite"
+)
+
+// QueueAssignmentRule sets the queue and priority class of the workloads it matches.
+// A rule matches a workload when all of namespaceLabels, groups and workloadLabels
+// match. A rule without any of them matches every workload.
+// +kubebuilder:validation:XValidation:rule="has(self.queueName) || has(self.priorityClass)",message="at least one of queueName and priorityClass must be set"
+type QueueAssignmentRule struct {
+	// name identifies the rule.
+	// Must be a valid DNS 1123 label consisting of lower-case alphanumeric characters
+	// and hyphens, of at most 63 characters in length.
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self.matches(r'^[a-z0-9]([-a-z0-9]*[a-z0-9])?$')",message="name must be a valid DNS 1123 label"
+	// +required
+	Name string `json:"name"`
+	// namespaceLabels are the labels the namespace of the workload must have.
+	// namespaceLabels, if specified, can not have more than 8 entries.
+	// +kubebuilder:validation:MaxProperties=8
+	// +optional
+	NamespaceLabels map[string]string `json:"namespaceLabels,omitempty"`
+	// groups match workloads created by a user that belongs to at least one of them.
+	// groups, if specified, can not have more than 16 items.
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=253
+	// +optional
+	Groups []string `json:"groups,omitempty"`
+	// workloadLabels are the labels the workload must have.
+	// workloadLabels, if specified, can not have more than 8 entries.
+	// +kubebuilder:validation:MaxProperties=8
+	// +optional
+	WorkloadLabels map[string]string `json:"workloadLabels,omitempty"`
+	// queueName is the LocalQueue set in the kueue.x-k8s.io/queue-name label.
+	// When omitted, the rule does not set the label.
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:XValidation:rule="self.matches(r'^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$')",message="queueName must be a valid DNS 1123 subdomain"
+	// +optional
+	QueueName string `json:"queueName,omitempty"`
+	// priorityClass is the WorkloadPriorityClass set in the
+	// kueue.x-k8s.io/priority-class label.
+	// When omitted, the rule does not set the label.
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:XValidation:rule="self.matches(r'^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$')",message="priorityClass must be a valid DNS 1123 subdomain"
+	// +optional
+	PriorityClass string `json:"priorityClass,omitempty"`
+	// mode controls whether the rule replaces the labels set on the workload.
+	// The allowed values are SetIfAbsent and Overwrite.
+	// SetIfAbsent only sets the labels the workload is created without.
+	// Overwrite replaces the labels set on the workload.
+	// When set to "", this means no opinion and the operator will choose a reasonable default.
+	// The current default is SetIfAbsent.
+	// +optional
+	Mode QueueAssignmentMode `json:"mode,omitempty"`
+}
+
+// NamespaceOnboarding selects the ClusterQueue of the default LocalQueue of each
+// managed namespace.
+// +kubebuilder:validation:MinProperties=1
+type NamespaceOnboarding struct {
+	// clusterQueueMappings select the ClusterQueue by namespace labels.
+	// The first mapping whose namespaceLabels are all set on the namespace is used.
+	// clusterQueueMappings, if specified, can not have more than 16 items.
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	ClusterQueueMappings []ClusterQueueMapping `json:"clusterQueueMappings,omitempty"`
+	// defaultClusterQueue is the ClusterQueue used when neither the namespace
+	// annotation nor clusterQueueMappings select one.
+	// When omitted, such namespaces get no default LocalQueue.
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:XValidation:rule="self.matches(r'^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$')",message="defaultClusterQueue must be a valid DNS 1123 subdomain"
+	// +optional
+	DefaultClusterQueue string `json:"defaultClusterQueue,omitempty"`
+}
+
+// ClusterQueueMapping maps the namespaces with a set of labels to a ClusterQueue.
+type ClusterQueueMapping struct {
+	// namespaceLabels are the labels a namespace must have for the mapping to apply.
+	// namespaceLabels must have at least one entry and no more than 8 entries.
+	// +kubebuilder:validation:MinProperties=1
+	// +kubebuilder:validation:MaxProperties=8
+	// +required
+	NamespaceLabels map[string]string `json:"namespaceLabels"`
+	// clusterQueue is the name of the ClusterQueue the default LocalQueue points to.
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self.matches(r'^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$')",message="clusterQueue must be a valid DNS 1123 subdomain"
+	// +required
+	ClusterQueue string `json:"clusterQueue"`
+}
+
+// ResourceFlavorTemplate describes a ResourceFlavor created by the operator.
+type ResourceFlavorTemplate struct {
+	// name is the name of the ResourceFlavor.
+	// Must be a valid DNS 1123 subdomain consisting of lower-case alphanumeric characters,
+	// hyphens and periods, of at most 253 characters in length.
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self.matches(r'^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$')",message="name must be a valid DNS 1123 subdomain"
+	// +required
+	Name string `json:"name"`
+	// nodeLabels are the labels of the nodes that provide this flavor.
+	// nodeLabels, if specified, can not have more than 8 entries.
+	// +kubebuilder:validation:MaxProperties=8
+	// +optional
+	NodeLabels map[string]string `json:"nodeLabels,omitempty"`
+}
+
+// CohortTemplate describes a Cohort created by the operator.
+type CohortTemplate struct {
+	// name is the name of the Cohort.
+	// Must be a valid DNS 1123 label consisting of lower-case alphanumeric characters
+	// and hyphens, of at most 63 characters in length.
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self.matches(r'^[a-z0-9]([-a-z0-9]*[a-z0-9])?$')",message="name must be a valid DNS 1123 label"
+	// +required
+	Name string `json:"name"`
+	// parentName is the name of the parent Cohort.
+	// When omitted, the Cohort is a root Cohort.
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:XValidation:rule="self.matches(r'^[a-z0-9]([-a-z0-9]*[a-z0-9])?$')",message="parentName must be a valid DNS 1123 label"
+	// +optional
+	ParentName string `json:"parentName,omitempty"`
+}
+
+// ClusterQueueTemplate describes a ClusterQueue created by the operator.
+type ClusterQueueTemplate struct {
+	// name is the name of the ClusterQueue.
+	// Must be a valid DNS 1123 subdomain consisting of lower-case alphanumeric characters,
+	// hyphens and periods, of at most 253 characters in length.
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self.matches(r'^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$')",message="name must be a valid DNS 1123 subdomain"
+	// +required
+	Name string `json:"name"`
+	// cohortName is the name of the Cohort the ClusterQueue belongs to.
+	// When omitted, the ClusterQueue does not belong to a Cohort.
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:XValidation:rule="self.matches(r'^[a-z0-9]([-a-z0-9]*[a-z0-9])?$')",message="cohortName must be a valid DNS 1123 label"
+	// +optional
+	CohortName string `json:"cohortName,omitempty"`
+	// resourceGroups describe the quotas of the ClusterQueue.
+	// resourceGroups must have at least one item and no more than 16 items.
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +required
+	ResourceGroups []QueueResourceGroup `json:"resourceGroups"`
+}
+
+// QueueResourceGroup describes the quotas of a group of resources, for each flavor.
+type QueueResourceGroup struct {
+	// coveredResources are the resources covered by the flavors of this group,
+	// e.g. cpu, memory or nvidia.com/gpu.
+	// coveredResources must have at least one item and no more than 16 items.
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:items:MaxLength=253
+	// +required
+	CoveredResources []string `json:"coveredResources"`
+	// flavors are the flavors providing the covered resources, in the order they are tried.
+	// flavors must have at least one item and no more than 16 items.
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +required
+	Flavors []FlavorQuotas `json:"flavors"`
+}
+
+// FlavorQuotas describes the quotas of a flavor.
+type FlavorQuotas struct {
+	// name is the name of the ResourceFlavor.
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Name string `json:"name"`
+	// resources are the quotas of each covered resource.
+	// resources must have at least one item and no more than 16 items.
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +required
+	Resources []ResourceQuota `json:"resources"`
+}
+
+// ResourceQuota describes the quota of a resource.
+type ResourceQuota struct {
+	// name is the name of the resource.
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Name string `json:"name"`
+	// nominalQuota is the quantity of the resource available to the ClusterQueue.
+	// +required
+	NominalQuota resource.Quantity `json:"nominalQuota"`
+	// borrowingLimit is the maximum quantity of the resource the ClusterQueue can
+	// borrow from its Cohort.
+	// When omitted, there is no limit.
+	// +optional
+	BorrowingLimit *resource.Quantity `json:"borrowingLimit,omitempty"`
+	// lendingLimit is the maximum quantity of the resource the ClusterQueue can
+	// lend to its Cohort.
+	// When omitted, there is no limit.
+	// +optional
+	LendingLimit *resource.Quantity `json:"lendingLimit,omitempty"`
+}
+
+// LocalQueueTemplate describes a LocalQueue created by the operator in every managed namespace.
+type LocalQueueTemplate struct {
+	// name is the name of the LocalQueue.
+	// Must be a valid DNS 1123 subdomain consisting of lower-case alphanumeric characters,
+	// hyphens and periods, of at most 253 characters in length.
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self.matches(r'^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$')",message="name must be a valid DNS 1123 subdomain"
+	// +required
+	Name string `json:"name"`
+	// clusterQueue is the name of the ClusterQueue the LocalQueue points to.
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	ClusterQueue string `json:"clusterQueue"`
 }
 
 type KueueConfiguration struct {
@@ -82,11 +774,225 @@ type KueueConfiguration struct {
 	// If multiKueue is not specified, MultiKueue is disabled.
 	// +optional
 	MultiKueue *MultiKueue `json:"multiKueue,omitempty"`
+	// rbac configures the ClusterRoles the operator installs for Kueue.
+	// The editor and viewer ClusterRoles of an integration are only installed
+	// when the integration is enabled.
+	// This field is optional.
+	// +optional
+	RBAC RBAC `json:"rbac,omitzero"`
+	// metrics configures the metrics Kueue reports and how they are scraped.
+	// This field is optional.
+	// If metrics is not specified, the operator will decide the defaults.
+	// These defaults could change over time.
+	// +optional
+	Metrics Metrics `json:"metrics,omitzero"`
 }
 
 // KueueStatus defines the observed state of Kueue
 type KueueStatus struct {
 	operatorv1.OperatorStatus `json:",inline"`
+	// integrations reports whether the APIs required by each enabled
+	// integration are installed on the cluster.
+	// integrations, if specified, can not have more than 18 items.
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=18
+	// +optional
+	Integrations []IntegrationStatus `json:"integrations,omitempty"`
+	// discoveredDeviceClassMappings are the DeviceClass mappings derived from
+	// the DeviceClasses installed on the cluster when
+	// spec.config.resources.autoDiscover.mode is Propose or Generate.
+	// discoveredDeviceClassMappings, if specified, can not have more than 16 items.
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	DiscoveredDeviceClassMappings []DeviceClassMapping `json:"discoveredDeviceClassMappings,omitempty"`
+	// labelPolicyPreview reports the objects that would become managed by Kueue when
+	// labelPolicy changes from QueueName to None.
+	// It is reported while the change is pending, or when previewed by annotating the
+	// Kueue CR with kueue.openshift.io/preview-label-policy=None.
+	// +optional
+	LabelPolicyPreview LabelPolicyPreview `json:"labelPolicyPreview,omitzero"`
+	// effectiveConfiguration is the configuration Kueue is deployed with, where the
+	// values left to the operator are resolved.
+	// +optional
+	EffectiveConfiguration EffectiveConfiguration `json:"effectiveConfiguration,omitzero"`
+	// kueueImage is the image of the Kueue deployment.
+	// kueueImage, if specified, can not be longer than 512 characters.
+	// +kubebuilder:validation:MaxLength=512
+	// +optional
+	KueueImage string `json:"kueueImage,omitempty"`
+	// kueueVersion is the version of the Kueue Go module the operator is built with,
+	// falling back to the Kueue release its API versions apply to. It is not read from
+	// kueueImage, which is configured separately and may hold another release.
+	// kueueVersion, if specified, can not be longer than 64 characters.
+	// +kubebuilder:validation:MaxLength=64
+	// +optional
+	KueueVersion string `json:"kueueVersion,omitempty"`
+	// relatedObjects are the objects the operator manages: the namespace of Kueue,
+	// which holds its namespaced objects, and the cluster-scoped objects and the
+	// objects of the other namespaces.
+	// relatedObjects, if specified, can not have more than 512 items.
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=512
+	// +optional
+	RelatedObjects []RelatedObject `json:"relatedObjects,omitempty"`
+}
+
+// EffectiveConfiguration is the configuration Kueue is deployed with.
+type EffectiveConfiguration struct {
+	// gangScheduling is the gang scheduling policy of Kueue.
+	// The values are ByWorkload and None.
+	// +optional
+	GangScheduling GangSchedulingPolicy `json:"gangScheduling,omitempty"`
+	// gangSchedulingAdmission is how the workloads are admitted with the ByWorkload
+	// gang scheduling policy.
+	// The values are Sequential and Parallel, it is omitted with the None policy.
+	// +optional
+	GangSchedulingAdmission GangSchedulingWorkloadAdmission `json:"gangSchedulingAdmission,omitempty"`
+	// preemptionPolicy is the preemption policy of Kueue.
+	// The values are Classical and FairSharing.
+	// +optional
+	PreemptionPolicy PreemptionPolicy `json:"preemptionPolicy,omitempty"`
+	// labelPolicy is the label policy Kueue manages the workloads with.
+	// It stays QueueName while a change to None waits for its acknowledgement.
+	// The values are QueueName and None.
+	// +optional
+	LabelPolicy LabelPolicy `json:"labelPolicy,omitempty"`
+	// integrations are the integrations enabled in Kueue: the ones of the
+	// configuration whose APIs are available, and Pod when the Deployment,
+	// StatefulSet or LeaderWorkerSet integration is enabled, as their pods are gated
+	// through the Pod integration.
+	// integrations, if specified, can not have more than 18 items.
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=18
+	// +optional
+	Integrations []KueueIntegration `json:"integrations,omitempty"`
+	// featureGates are the feature gates of Kueue the operator enables.
+	// featureGates, if specified, can not have more than 32 items.
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=32
+	// +kubebuilder:validation:items:MaxLength=128
+	// +optional
+	FeatureGates []string `json:"featureGates,omitempty"`
+	// configHash is the hash of the configuration rendered for Kueue, which changes
+	// with every change of the configuration the Kueue deployment is rolled out on.
+	// configHash, if specified, can not be longer than 64 characters.
+	// +kubebuilder:validation:MaxLength=64
+	// +optional
+	ConfigHash string `json:"configHash,omitempty"`
+}
+
+// RelatedObject is an object managed by the operator.
+type RelatedObject struct {
+	// group is the API group of the object, empty for the core group.
+	// group can not be longer than 253 characters.
+	// +kubebuilder:validation:MaxLength=253
+	// +required
+	Group string `json:"group"`
+	// resource is the resource of the object.
+	// resource can not be longer than 63 characters.
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Resource string `json:"resource"`
+	// namespace is the namespace of the object, empty for cluster-scoped objects.
+	// namespace, if specified, can not be longer than 63 characters.
+	// +kubebuilder:validation:MaxLength=63
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// name is the name of the object.
+	// name can not be longer than 253 characters.
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Name string `json:"name"`
+}
+
+// LabelPolicyPreview reports the objects that would become managed by Kueue with a
+// label policy.
+type LabelPolicyPreview struct {
+	// labelPolicy is the previewed label policy.
+	// +required
+	LabelPolicy LabelPolicy `json:"labelPolicy,omitempty"`
+	// total is the number of objects that would become managed by Kueue: the objects
+	// of the enabled integrations in the managed namespaces that do not set the label
+	// kueue.x-k8s.io/queue-name and are not owned by another object.
+	// +kubebuilder:validation:Minimum=0
+	// +required
+	Total *int32 `json:"total,omitempty"`
+	// objects are the number of objects by namespace and integration, the largest
+	// first.
+	// objects, if specified, can not have more than 64 items. The others are only
+	// counted in total.
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=64
+	// +optional
+	Objects []LabelPolicyPreviewObjects `json:"objects,omitempty"`
+	// lastUpdateTime is the time the preview was computed.
+	// +required
+	LastUpdateTime metav1.Time `json:"lastUpdateTime,omitzero"`
+}
+
+// LabelPolicyPreviewObjects is the number of objects of an integration in a namespace
+// that would become managed by Kueue.
+type LabelPolicyPreviewObjects struct {
+	// namespace is the namespace of the objects.
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Namespace string `json:"namespace,omitempty"`
+	// integration is the integration of the objects.
+	// +required
+	Integration KueueIntegration `json:"integration,omitempty"`
+	// count is the number of objects.
+	// +kubebuilder:validation:Minimum=1
+	// +required
+	Count int32 `json:"count,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Available;Missing;VersionMismatch
+type IntegrationState string
+
+const (
+	IntegrationStateAvailable       IntegrationState = "Available"
+	IntegrationStateMissing         IntegrationState = "Missing"
+	IntegrationStateVersionMismatch IntegrationState = "VersionMismatch"
+)
+
+// IntegrationStatus reports the state of the API required by an integration.
+type IntegrationStatus struct {
+	// name is the name of the integration.
+	// +required
+	Name KueueIntegration `json:"name"`
+	// state is the state of the API required by the integration.
+	// The allowed values are Available, Missing and VersionMismatch.
+	// Available means the API is served in the version Kueue requires.
+	// Missing means the CustomResourceDefinition of the API is not installed.
+	// VersionMismatch means the CustomResourceDefinition is installed but does
+	// not serve the version Kueue requires.
+	// +required
+	State IntegrationState `json:"state"`
+	// message is a human readable description of the state.
+	// message, if specified, can not be longer than 1024 characters.
+	// +kubebuilder:validation:MaxLength=1024
+	// +optional
+	Message string `json:"message,omitempty"`
+	// servedVersions are the versions served by the CustomResourceDefinition
+	// of the integration.
+	// servedVersions, if specified, can not have more than 16 items.
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	ServedVersions []string `json:"servedVersions,omitempty"`
+	// requiredVersion is the version of the integration API the Kueue release
+	// deployed by the operator manages. Kueue manages a single version of each
+	// integration API, which the CustomResourceDefinition must serve.
+	// requiredVersion, if specified, can not be longer than 64 characters.
+	// +kubebuilder:validation:MaxLength=64
+	// +optional
+	RequiredVersion string `json:"requiredVersion,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -199,8 +1105,62 @@ type Integrations struct {
 	// +listMapKey=key
 	// +optional
 	LabelKeysToCopy []LabelKeys `json:"labelKeysToCopy,omitempty"`
+	// missingDependencyPolicy controls what the operator does when the API
+	// required by an enabled framework is not installed on the cluster, or is
+	// not served in the version Kueue requires.
+	// The allowed values are Degrade, Skip and "".
+	// When set to Degrade, the framework is kept in the Kueue configuration and
+	// the operator reports a Degraded condition until the API becomes available.
+	// When set to Skip, the framework is left out of the Kueue configuration
+	// until the API becomes available, and the remaining frameworks are deployed.
+	// When set to "", this means no opinion and the operator is left
+	// to choose a reasonable default, which is subject to change over time.
+	// The current default is Degrade.
+	// +optional
+	MissingDependencyPolicy MissingDependencyPolicy `json:"missingDependencyPolicy,omitempty"`
+	// skipIncompatibleFrameworks are frameworks that are left out of the Kueue
+	// configuration when their API is installed in a version that is not supported
+	// by the Kueue release deployed by the operator, instead of degrading the operator.
+	// The supported and served versions of each framework are reported in
+	// status.integrations.
+	// skipIncompatibleFrameworks, if specified, can not have more than 18 items.
+	// +kubebuilder:validation:MaxItems=18
+	// +listType=set
+	// +optional
+	SkipIncompatibleFrameworks []KueueIntegration `json:"skipIncompatibleFrameworks,omitempty"`
+	// removedFrameworksPolicy controls what the operator does with the workloads of
+	// the suspendable frameworks, such as BatchJob or RayJob, that are removed from
+	// the Kueue configuration, either from frameworks or because their API is not
+	// available anymore. Kueue does not manage them anymore: the jobs it suspended stay
+	// suspended, and their Workloads keep holding quota.
+	// The allowed values are Report, Release and "".
+	// When set to Report, the remaining Workloads are reported in the
+	// IntegrationRemovalProgressing condition until they are deleted.
+	// When set to Release, once Kueue runs without the removed frameworks, the jobs
+	// waiting for admission are unsuspended and the Workloads are deleted.
+	// When set to "", this means no opinion and the operator is left
+	// to choose a reasonable default, which is subject to change over time.
+	// The current default is Report.
+	// +optional
+	RemovedFrameworksPolicy RemovedFrameworksPolicy `json:"removedFrameworksPolicy,omitempty"`
 }
 
+// +kubebuilder:validation:Enum="";Degrade;Skip
+type MissingDependencyPolicy string
+
+const (
+	MissingDependencyPolicyDegrade MissingDependencyPolicy = "Degrade"
+	MissingDependencyPolicySkip    MissingDependencyPolicy = "Skip"
+)
+
+// +kubebuilder:validation:Enum="";Report;Release
+type RemovedFrameworksPolicy string
+
+const (
+	RemovedFrameworksPolicyReport  RemovedFrameworksPolicy = "Report"
+	RemovedFrameworksPolicyRelease RemovedFrameworksPolicy = "Release"
+)
+
 type LabelKeys struct {
 	// key is the label key.
 	// A label key must be a valid qualified name consisting of a lower-case alphanumeric string,
@@ -303,8 +1263,31 @@ type WorkloadManagement struct {
 	// The current default is QueueName.
 	// +required
 	LabelPolicy LabelPolicy `json:"labelPolicy"`
+	// labelPolicySwitch controls how a change of labelPolicy from QueueName to None is
+	// applied. With None, Kueue suspends every job of the managed namespaces that does
+	// not set the label kueue.x-k8s.io/queue-name, so the objects that would become
+	// managed are previewed in status.labelPolicyPreview.
+	// The allowed values are Immediate, RequireAcknowledgement and "".
+	// Immediate applies the change right away.
+	// RequireAcknowledgement keeps Kueue running with QueueName until the Kueue CR is
+	// annotated with kueue.openshift.io/acknowledge-label-policy=None.
+	// The annotation should be removed once the change is applied, so that a later
+	// change requires a new acknowledgement.
+	// When set to "", this means no opinion and the operator is left
+	// to choose a reasonable default, which is subject to change over time.
+	// The current default is Immediate.
+	// +optional
+	LabelPolicySwitch LabelPolicySwitch `json:"labelPolicySwitch,omitempty"`
 }
 
+// +kubebuilder:validation:Enum="";Immediate;RequireAcknowledgement
+type LabelPolicySwitch string
+
+const (
+	LabelPolicySwitchImmediate              LabelPolicySwitch = "Immediate"
+	LabelPolicySwitchRequireAcknowledgement LabelPolicySwitch = "RequireAcknowledgement"
+)
+
 // +kubebuilder:validation:Enum="";Classical;FairSharing
 type PreemptionPolicy string
 
@@ -349,6 +1332,43 @@ type Resources struct {
 	// +kubebuilder:validation:XValidation:rule="self.all(m1, m1.deviceClassNames.all(d, self.all(m2, m2 == m1 || !m2.deviceClassNames.exists(e, e == d))))",message="each DeviceClass name can only appear in one mapping"
 	// +optional
 	DeviceClassMappings []DeviceClassMapping `json:"deviceClassMappings,omitempty"`
+	// autoDiscover configures how the operator derives deviceClassMappings
+	// from the DeviceClasses installed on the cluster.
+	// A DeviceClass is mapped to the Kueue resource name set in its
+	// kueue.openshift.io/resource-name label, or else to the name of the DRA
+	// driver selected by the DeviceClass.
+	// DeviceClasses already listed in deviceClassMappings are not discovered.
+	// autoDiscover is optional.
+	// +optional
+	AutoDiscover DeviceClassAutoDiscover `json:"autoDiscover,omitzero"`
+}
+
+// +kubebuilder:validation:Enum="";Disabled;Propose;Generate
+type DeviceClassAutoDiscoverMode string
+
+const (
+	DeviceClassAutoDiscoverModeDisabled DeviceClassAutoDiscoverMode = "Disabled"
+	DeviceClassAutoDiscoverModePropose  DeviceClassAutoDiscoverMode = "Propose"
+	DeviceClassAutoDiscoverModeGenerate DeviceClassAutoDiscoverMode = "Generate"
+)
+
+// DeviceClassAutoDiscover configures the discovery of DeviceClass mappings.
+// +kubebuilder:validation:MinProperties=1
+type DeviceClassAutoDiscover struct {
+	// mode controls whether DeviceClass mappings are discovered, and what is
+	// done with them.
+	// The allowed values are Disabled, Propose, Generate and "".
+	// When set to Disabled, no mappings are discovered.
+	// When set to Propose, the discovered mappings are reported in
+	// status.discoveredDeviceClassMappings so they can be reviewed and copied
+	// to deviceClassMappings.
+	// When set to Generate, the discovered mappings are also reported, and are
+	// added to the Kueue configuration together with deviceClassMappings.
+	// When set to "", this means no opinion and the operator is left
+	// to choose a reasonable default, which is subject to change over time.
+	// The current default is Disabled.
+	// +optional
+	Mode DeviceClassAutoDiscoverMode `json:"mode,omitempty"`
 }
 
 // DeviceClassMapping maps Kubernetes DeviceClass names to a Kueue resource name.
@@ -411,3 +1431,92 @@ type MultiKueue struct {
 	// +optional
 	ExternalFrameworks []ExternalFramework `json:"externalFrameworks,omitempty"`
 }
+
+// +kubebuilder:validation:Enum="";Enabled;Disabled
+type DefaultRoleAggregation string
+
+const (
+	DefaultRoleAggregationEnabled  DefaultRoleAggregation = "Enabled"
+	DefaultRoleAggregationDisabled DefaultRoleAggregation = "Disabled"
+)
+
+// RBAC configures the ClusterRoles installed for Kueue.
+// +kubebuilder:validation:MinProperties=1
+type RBAC struct {
+	// defaultRoleAggregation controls whether the namespaced Kueue ClusterRoles
+	// are aggregated into the OpenShift admin, edit and view ClusterRoles.
+	// The allowed values are Enabled, Disabled and "".
+	// When set to Enabled, the editor roles of the enabled integrations are aggregated
+	// into edit and admin, their viewer roles and the LocalQueue, Workload and
+	// pending workloads viewer roles are aggregated into view, and the LocalQueue
+	// editor role is aggregated into admin.
+	// When set to Disabled, the roles are only aggregated into the Kueue
+	// batch-user and batch-admin ClusterRoles.
+	// When set to "", this means no opinion and the operator is left
+	// to choose a reasonable default, which is subject to change over time.
+	// The current default is Disabled.
+	// +optional
+	DefaultRoleAggregation DefaultRoleAggregation `json:"defaultRoleAggregation,omitempty"`
+}
+
+// +kubebuilder:validation:Enum="";Enabled;Disabled
+type MetricsState string
+
+const (
+	MetricsStateEnabled  MetricsState = "Enabled"
+	MetricsStateDisabled MetricsState = "Disabled"
+)
+
+// Metrics configures the metrics of Kueue.
+// +kubebuilder:validation:MinProperties=1
+// +kubebuilder:validation:XValidation:rule="!has(self.tenantLocalQueueMetrics) || self.tenantLocalQueueMetrics != 'Enabled' || (has(self.localQueueMetrics) && self.localQueueMetrics == 'Enabled')",message="tenantLocalQueueMetrics requires localQueueMetrics to be Enabled"
+type Metrics struct {
+	// scrapeInterval is the interval Prometheus scrapes the metrics of Kueue and of
+	// the operator at, as a number of minutes and seconds, for example 1m or 15s.
+	// scrapeInterval must be greater than zero.
+	// When omitted, this means no opinion and the operator will choose a reasonable default.
+	// The current default is 30s.
+	// +kubebuilder:validation:MinLength=2
+	// +kubebuilder:validation:MaxLength=8
+	// +kubebuilder:validation:Pattern=`^([0-9]*[1-9][0-9]*m([0-9]+s)?|([0-9]+m)?[0-9]*[1-9][0-9]*s)$`
+	// +optional
+	ScrapeInterval string `json:"scrapeInterval,omitempty"`
+	// localQueueMetrics controls whether Kueue reports the metrics of the LocalQueues,
+	// such as their pending and admitted workloads and their resource usage.
+	// The allowed values are Enabled, Disabled and "".
+	// When set to Enabled, the LocalQueueMetrics feature gate of Kueue is enabled.
+	// When set to "", this means no opinion and the operator will choose a reasonable default.
+	// The current default is Disabled.
+	// +optional
+	LocalQueueMetrics MetricsState `json:"localQueueMetrics,omitempty"`
+	// tenantLocalQueueMetrics controls whether the users of a namespace can query the
+	// metrics of the LocalQueues of the namespace, from the Observe section of the
+	// console or through the tenancy port of the Thanos Querier, next to the metrics of
+	// their user workloads. The LocalQueue metrics are then recorded with the namespace
+	// of their LocalQueue, which the monitoring stack restricts the queries of the users
+	// to, so that they only see the queues of the namespaces they can view.
+	// The allowed values are Enabled, Disabled and "".
+	// When set to Enabled, localQueueMetrics must be Enabled too.
+	// When set to "", this means no opinion and the operator will choose a reasonable default.
+	// The current default is Disabled.
+	// +optional
+	TenantLocalQueueMetrics MetricsState `json:"tenantLocalQueueMetrics,omitempty"`
+	// clusterQueueResources controls whether Kueue reports the resource usage and the
+	// quotas of the ClusterQueues.
+	// The allowed values are Enabled, Disabled and "".
+	// When set to "", this means no opinion and the operator will choose a reasonable default.
+	// The current default is Enabled.
+	// +optional
+	ClusterQueueResources MetricsState `json:"clusterQueueResources,omitempty"`
+	// additionalNamespaces are the namespaces, besides the ones of the platform monitoring,
+	// the network policy of the metrics endpoints admits scrapes from, for example the
+	// namespace of a Prometheus instance of a team.
+	// additionalNamespaces, if specified, must have at least one item and no more than 16 items.
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:items:MaxLength=63
+	// +kubebuilder:validation:items:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +optional
+	AdditionalNamespaces []string `json:"additionalNamespaces,omitempty"`
+}
@@ -24,6 +24,27 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertConfiguration) DeepCopyInto(out *AlertConfiguration) {
+	*out = *in
+	if in.Threshold != nil {
+		in, out := &in.Threshold, &out.Threshold
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertConfiguration.
+func (in *AlertConfiguration) DeepCopy() *AlertConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ByWorkload) DeepCopyInto(out *ByWorkload) {
 	*out = *in
@@ -40,6 +61,149 @@ func (in *ByWorkload) DeepCopy() *ByWorkload {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterQueueMapping) DeepCopyInto(out *ClusterQueueMapping) {
+	*out = *in
+	if in.NamespaceLabels != nil {
+		in, out := &in.NamespaceLabels, &out.NamespaceLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterQueueMapping.
+func (in *ClusterQueueMapping) DeepCopy() *ClusterQueueMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterQueueMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterQueueTemplate) DeepCopyInto(out *ClusterQueueTemplate) {
+	*out = *in
+	if in.ResourceGroups != nil {
+		in, out := &in.ResourceGroups, &out.ResourceGroups
+		*out = make([]QueueResourceGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterQueueTemplate.
+func (in *ClusterQueueTemplate) DeepCopy() *ClusterQueueTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterQueueTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CohortTemplate) DeepCopyInto(out *CohortTemplate) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CohortTemplate.
+func (in *CohortTemplate) DeepCopy() *CohortTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(CohortTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapExport) DeepCopyInto(out *ConfigMapExport) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapExport.
+func (in *ConfigMapExport) DeepCopy() *ConfigMapExport {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapExport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerDefaults) DeepCopyInto(out *ContainerDefaults) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]ContainerResourceDefault, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerDefaults.
+func (in *ContainerDefaults) DeepCopy() *ContainerDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerResourceDefault) DeepCopyInto(out *ContainerResourceDefault) {
+	*out = *in
+	if in.DefaultRequest != nil {
+		in, out := &in.DefaultRequest, &out.DefaultRequest
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.DefaultLimit != nil {
+		in, out := &in.DefaultLimit, &out.DefaultLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerResourceDefault.
+func (in *ContainerResourceDefault) DeepCopy() *ContainerResourceDefault {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerResourceDefault)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceClassAutoDiscover) DeepCopyInto(out *DeviceClassAutoDiscover) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceClassAutoDiscover.
+func (in *DeviceClassAutoDiscover) DeepCopy() *DeviceClassAutoDiscover {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceClassAutoDiscover)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeviceClassMapping) DeepCopyInto(out *DeviceClassMapping) {
 	*out = *in
@@ -61,6 +225,32 @@ func (in *DeviceClassMapping) DeepCopy() *DeviceClassMapping {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EffectiveConfiguration) DeepCopyInto(out *EffectiveConfiguration) {
+	*out = *in
+	if in.Integrations != nil {
+		in, out := &in.Integrations, &out.Integrations
+		*out = make([]KueueIntegration, len(*in))
+		copy(*out, *in)
+	}
+	if in.FeatureGates != nil {
+		in, out := &in.FeatureGates, &out.FeatureGates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EffectiveConfiguration.
+func (in *EffectiveConfiguration) DeepCopy() *EffectiveConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(EffectiveConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalFramework) DeepCopyInto(out *ExternalFramework) {
 	*out = *in
@@ -77,6 +267,29 @@ func (in *ExternalFramework) DeepCopy() *ExternalFramework {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlavorQuotas) DeepCopyInto(out *FlavorQuotas) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]ResourceQuota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlavorQuotas.
+func (in *FlavorQuotas) DeepCopy() *FlavorQuotas {
+	if in == nil {
+		return nil
+	}
+	out := new(FlavorQuotas)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GangScheduling) DeepCopyInto(out *GangScheduling) {
 	*out = *in
@@ -98,6 +311,27 @@ func (in *GangScheduling) DeepCopy() *GangScheduling {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntegrationStatus) DeepCopyInto(out *IntegrationStatus) {
+	*out = *in
+	if in.ServedVersions != nil {
+		in, out := &in.ServedVersions, &out.ServedVersions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationStatus.
+func (in *IntegrationStatus) DeepCopy() *IntegrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IntegrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Integrations) DeepCopyInto(out *Integrations) {
 	*out = *in
@@ -116,6 +350,11 @@ func (in *Integrations) DeepCopyInto(out *Integrations) {
 		*out = make([]LabelKeys, len(*in))
 		copy(*out, *in)
 	}
+	if in.SkipIncompatibleFrameworks != nil {
+		in, out := &in.SkipIncompatibleFrameworks, &out.SkipIncompatibleFrameworks
+		*out = make([]KueueIntegration, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -170,6 +409,8 @@ func (in *KueueConfiguration) DeepCopyInto(out *KueueConfiguration) {
 		*out = new(MultiKueue)
 		(*in).DeepCopyInto(*out)
 	}
+	out.RBAC = in.RBAC
+	in.Metrics.DeepCopyInto(&out.Metrics)
 	return
 }
 
@@ -221,6 +462,11 @@ func (in *KueueOperandSpec) DeepCopyInto(out *KueueOperandSpec) {
 	*out = *in
 	in.OperatorSpec.DeepCopyInto(&out.OperatorSpec)
 	in.Config.DeepCopyInto(&out.Config)
+	in.Queues.DeepCopyInto(&out.Queues)
+	in.NamespaceProtection.DeepCopyInto(&out.NamespaceProtection)
+	in.ContainerDefaults.DeepCopyInto(&out.ContainerDefaults)
+	out.UninstallExport = in.UninstallExport
+	in.Monitoring.DeepCopyInto(&out.Monitoring)
 	return
 }
 
@@ -238,6 +484,27 @@ func (in *KueueOperandSpec) DeepCopy() *KueueOperandSpec {
 func (in *KueueStatus) DeepCopyInto(out *KueueStatus) {
 	*out = *in
 	in.OperatorStatus.DeepCopyInto(&out.OperatorStatus)
+	if in.Integrations != nil {
+		in, out := &in.Integrations, &out.Integrations
+		*out = make([]IntegrationStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DiscoveredDeviceClassMappings != nil {
+		in, out := &in.DiscoveredDeviceClassMappings, &out.DiscoveredDeviceClassMappings
+		*out = make([]DeviceClassMapping, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.LabelPolicyPreview.DeepCopyInto(&out.LabelPolicyPreview)
+	in.EffectiveConfiguration.DeepCopyInto(&out.EffectiveConfiguration)
+	if in.RelatedObjects != nil {
+		in, out := &in.RelatedObjects, &out.RelatedObjects
+		*out = make([]RelatedObject, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -267,6 +534,109 @@ func (in *LabelKeys) DeepCopy() *LabelKeys {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LabelPolicyPreview) DeepCopyInto(out *LabelPolicyPreview) {
+	*out = *in
+	if in.Total != nil {
+		in, out := &in.Total, &out.Total
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Objects != nil {
+		in, out := &in.Objects, &out.Objects
+		*out = make([]LabelPolicyPreviewObjects, len(*in))
+		copy(*out, *in)
+	}
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LabelPolicyPreview.
+func (in *LabelPolicyPreview) DeepCopy() *LabelPolicyPreview {
+	if in == nil {
+		return nil
+	}
+	out := new(LabelPolicyPreview)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LabelPolicyPreviewObjects) DeepCopyInto(out *LabelPolicyPreviewObjects) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LabelPolicyPreviewObjects.
+func (in *LabelPolicyPreviewObjects) DeepCopy() *LabelPolicyPreviewObjects {
+	if in == nil {
+		return nil
+	}
+	out := new(LabelPolicyPreviewObjects)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalQueueTemplate) DeepCopyInto(out *LocalQueueTemplate) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalQueueTemplate.
+func (in *LocalQueueTemplate) DeepCopy() *LocalQueueTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalQueueTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Metrics) DeepCopyInto(out *Metrics) {
+	*out = *in
+	if in.AdditionalNamespaces != nil {
+		in, out := &in.AdditionalNamespaces, &out.AdditionalNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Metrics.
+func (in *Metrics) DeepCopy() *Metrics {
+	if in == nil {
+		return nil
+	}
+	out := new(Metrics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Monitoring) DeepCopyInto(out *Monitoring) {
+	*out = *in
+	if in.Alerts != nil {
+		in, out := &in.Alerts, &out.Alerts
+		*out = make([]AlertConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Monitoring.
+func (in *Monitoring) DeepCopy() *Monitoring {
+	if in == nil {
+		return nil
+	}
+	out := new(Monitoring)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MultiKueue) DeepCopyInto(out *MultiKueue) {
 	*out = *in
@@ -288,6 +658,55 @@ func (in *MultiKueue) DeepCopy() *MultiKueue {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceOnboarding) DeepCopyInto(out *NamespaceOnboarding) {
+	*out = *in
+	if in.ClusterQueueMappings != nil {
+		in, out := &in.ClusterQueueMappings, &out.ClusterQueueMappings
+		*out = make([]ClusterQueueMapping, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceOnboarding.
+func (in *NamespaceOnboarding) DeepCopy() *NamespaceOnboarding {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceOnboarding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceProtection) DeepCopyInto(out *NamespaceProtection) {
+	*out = *in
+	if in.AllowedGroups != nil {
+		in, out := &in.AllowedGroups, &out.AllowedGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LabelKeys != nil {
+		in, out := &in.LabelKeys, &out.LabelKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceProtection.
+func (in *NamespaceProtection) DeepCopy() *NamespaceProtection {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceProtection)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Preemption) DeepCopyInto(out *Preemption) {
 	*out = *in
@@ -304,6 +723,217 @@ func (in *Preemption) DeepCopy() *Preemption {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueueAssignmentPolicy) DeepCopyInto(out *QueueAssignmentPolicy) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]QueueAssignmentRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueAssignmentPolicy.
+func (in *QueueAssignmentPolicy) DeepCopy() *QueueAssignmentPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(QueueAssignmentPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueueAssignmentRule) DeepCopyInto(out *QueueAssignmentRule) {
+	*out = *in
+	if in.NamespaceLabels != nil {
+		in, out := &in.NamespaceLabels, &out.NamespaceLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.WorkloadLabels != nil {
+		in, out := &in.WorkloadLabels, &out.WorkloadLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueAssignmentRule.
+func (in *QueueAssignmentRule) DeepCopy() *QueueAssignmentRule {
+	if in == nil {
+		return nil
+	}
+	out := new(QueueAssignmentRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueueResourceGroup) DeepCopyInto(out *QueueResourceGroup) {
+	*out = *in
+	if in.CoveredResources != nil {
+		in, out := &in.CoveredResources, &out.CoveredResources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Flavors != nil {
+		in, out := &in.Flavors, &out.Flavors
+		*out = make([]FlavorQuotas, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueResourceGroup.
+func (in *QueueResourceGroup) DeepCopy() *QueueResourceGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(QueueResourceGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Queues) DeepCopyInto(out *Queues) {
+	*out = *in
+	if in.ResourceFlavors != nil {
+		in, out := &in.ResourceFlavors, &out.ResourceFlavors
+		*out = make([]ResourceFlavorTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Cohorts != nil {
+		in, out := &in.Cohorts, &out.Cohorts
+		*out = make([]CohortTemplate, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClusterQueues != nil {
+		in, out := &in.ClusterQueues, &out.ClusterQueues
+		*out = make([]ClusterQueueTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LocalQueues != nil {
+		in, out := &in.LocalQueues, &out.LocalQueues
+		*out = make([]LocalQueueTemplate, len(*in))
+		copy(*out, *in)
+	}
+	in.NamespaceOnboarding.DeepCopyInto(&out.NamespaceOnboarding)
+	in.AssignmentPolicy.DeepCopyInto(&out.AssignmentPolicy)
+	in.TenantGuardrails.DeepCopyInto(&out.TenantGuardrails)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Queues.
+func (in *Queues) DeepCopy() *Queues {
+	if in == nil {
+		return nil
+	}
+	out := new(Queues)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RBAC) DeepCopyInto(out *RBAC) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RBAC.
+func (in *RBAC) DeepCopy() *RBAC {
+	if in == nil {
+		return nil
+	}
+	out := new(RBAC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RelatedObject) DeepCopyInto(out *RelatedObject) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RelatedObject.
+func (in *RelatedObject) DeepCopy() *RelatedObject {
+	if in == nil {
+		return nil
+	}
+	out := new(RelatedObject)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceFlavorTemplate) DeepCopyInto(out *ResourceFlavorTemplate) {
+	*out = *in
+	if in.NodeLabels != nil {
+		in, out := &in.NodeLabels, &out.NodeLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceFlavorTemplate.
+func (in *ResourceFlavorTemplate) DeepCopy() *ResourceFlavorTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceFlavorTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceQuota) DeepCopyInto(out *ResourceQuota) {
+	*out = *in
+	out.NominalQuota = in.NominalQuota.DeepCopy()
+	if in.BorrowingLimit != nil {
+		in, out := &in.BorrowingLimit, &out.BorrowingLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.LendingLimit != nil {
+		in, out := &in.LendingLimit, &out.LendingLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceQuota.
+func (in *ResourceQuota) DeepCopy() *ResourceQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Resources) DeepCopyInto(out *Resources) {
 	*out = *in
@@ -314,6 +944,7 @@ func (in *Resources) DeepCopyInto(out *Resources) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	out.AutoDiscover = in.AutoDiscover
 	return
 }
 
@@ -327,6 +958,84 @@ func (in *Resources) DeepCopy() *Resources {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantGuardrailRule) DeepCopyInto(out *TenantGuardrailRule) {
+	*out = *in
+	if in.NamespaceLabels != nil {
+		in, out := &in.NamespaceLabels, &out.NamespaceLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedQueueNames != nil {
+		in, out := &in.AllowedQueueNames, &out.AllowedQueueNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedPriorityClasses != nil {
+		in, out := &in.AllowedPriorityClasses, &out.AllowedPriorityClasses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantGuardrailRule.
+func (in *TenantGuardrailRule) DeepCopy() *TenantGuardrailRule {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantGuardrailRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantGuardrails) DeepCopyInto(out *TenantGuardrails) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]TenantGuardrailRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantGuardrails.
+func (in *TenantGuardrails) DeepCopy() *TenantGuardrails {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantGuardrails)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UninstallExport) DeepCopyInto(out *UninstallExport) {
+	*out = *in
+	out.ConfigMap = in.ConfigMap
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UninstallExport.
+func (in *UninstallExport) DeepCopy() *UninstallExport {
+	if in == nil {
+		return nil
+	}
+	out := new(UninstallExport)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkloadManagement) DeepCopyInto(out *WorkloadManagement) {
 	*out = *in
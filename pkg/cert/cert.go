@@ -14,11 +14,17 @@ import (
 )
 
 func InjectCertAnnotation(annotation map[string]string, namespace string) map[string]string {
+	return InjectCertAnnotationFrom(annotation, namespace, "webhook-cert")
+}
+
+// InjectCertAnnotationFrom sets the annotation injecting the CA of the given
+// cert-manager Certificate.
+func InjectCertAnnotationFrom(annotation map[string]string, namespace, certificateName string) map[string]string {
 	newAnnotation := annotation
 	if annotation == nil {
 		newAnnotation = map[string]string{}
 	}
-	newAnnotation["cert-manager.io/inject-ca-from"] = fmt.Sprintf("%s/webhook-cert", namespace)
+	newAnnotation["cert-manager.io/inject-ca-from"] = fmt.Sprintf("%s/%s", namespace, certificateName)
 	return newAnnotation
 }
 
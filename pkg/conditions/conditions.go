@@ -0,0 +1,85 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package conditions aggregates the prefixed conditions reported by the sub-controllers
+// of the operator into the top-level Available, Progressing and Degraded conditions.
+package conditions
+
+import (
+	"strings"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	applyoperatorv1 "github.com/openshift/client-go/operator/applyconfigurations/operator/v1"
+	"github.com/openshift/library-go/pkg/operator/status"
+	"k8s.io/utils/ptr"
+)
+
+// Aggregate returns the Available, Progressing and Degraded conditions that are the union
+// of the conditions with the same suffix, following the library-go conventions: the
+// operator is available when all are True, and progressing or degraded when one is True.
+// When a single condition is not as expected, its reason is kept as is, so that the
+// reasons reported before the conditions were split into sub-controllers, such as
+// UnsupportedTLSProfile and MissingDependencies, are unchanged. Otherwise, the reasons
+// are prefixed with the sub-controllers. The lastTransitionTime of the aggregated
+// conditions is left unset.
+func Aggregate(conditions []*applyoperatorv1.OperatorConditionApplyConfiguration) []*applyoperatorv1.OperatorConditionApplyConfiguration {
+	all := make([]operatorv1.OperatorCondition, 0, len(conditions))
+	for _, c := range conditions {
+		condition := operatorv1.OperatorCondition{
+			Type:    ptr.Deref(c.Type, ""),
+			Status:  ptr.Deref(c.Status, operatorv1.ConditionUnknown),
+			Reason:  ptr.Deref(c.Reason, ""),
+			Message: ptr.Deref(c.Message, ""),
+		}
+		if c.LastTransitionTime != nil {
+			condition.LastTransitionTime = *c.LastTransitionTime
+		}
+		all = append(all, condition)
+	}
+
+	aggregated := []*applyoperatorv1.OperatorConditionApplyConfiguration{}
+	for _, union := range []struct {
+		conditionType string
+		defaultStatus operatorv1.ConditionStatus
+	}{
+		{conditionType: operatorv1.OperatorStatusTypeAvailable, defaultStatus: operatorv1.ConditionTrue},
+		{conditionType: operatorv1.OperatorStatusTypeProgressing, defaultStatus: operatorv1.ConditionFalse},
+		{conditionType: operatorv1.OperatorStatusTypeDegraded, defaultStatus: operatorv1.ConditionFalse},
+	} {
+		condition := status.UnionCondition(union.conditionType, union.defaultStatus, nil, all...)
+		if unexpected := unexpectedConditions(union.conditionType, union.defaultStatus, all); len(unexpected) == 1 {
+			condition.Reason = unexpected[0].Reason
+		}
+		aggregated = append(aggregated, applyoperatorv1.OperatorCondition().
+			WithType(condition.Type).
+			WithStatus(condition.Status).
+			WithReason(condition.Reason).
+			WithMessage(condition.Message))
+	}
+	return aggregated
+}
+
+// unexpectedConditions returns the conditions of the type suffix whose status is not the
+// expected one.
+func unexpectedConditions(conditionType string, expected operatorv1.ConditionStatus, conditions []operatorv1.OperatorCondition) []operatorv1.OperatorCondition {
+	unexpected := []operatorv1.OperatorCondition{}
+	for _, condition := range conditions {
+		if strings.HasSuffix(condition.Type, conditionType) && condition.Status != expected {
+			unexpected = append(unexpected, condition)
+		}
+	}
+	return unexpected
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conditions
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	applyoperatorv1 "github.com/openshift/client-go/operator/applyconfigurations/operator/v1"
+)
+
+func condition(conditionType string, status operatorv1.ConditionStatus, reason, message string) *applyoperatorv1.OperatorConditionApplyConfiguration {
+	return applyoperatorv1.OperatorCondition().
+		WithType(conditionType).
+		WithStatus(status).
+		WithReason(reason).
+		WithMessage(message)
+}
+
+func TestAggregate(t *testing.T) {
+	testCases := map[string]struct {
+		conditions []*applyoperatorv1.OperatorConditionApplyConfiguration
+		want       []*applyoperatorv1.OperatorConditionApplyConfiguration
+	}{
+		"all is well": {
+			conditions: []*applyoperatorv1.OperatorConditionApplyConfiguration{
+				condition("CertificatesDegraded", operatorv1.ConditionFalse, "AsExpected", ""),
+				condition("CertificatesProgressing", operatorv1.ConditionFalse, "AsExpected", ""),
+				condition("OperandAvailable", operatorv1.ConditionTrue, "AllReplicasReady", "All 1 replicas are ready"),
+				condition("OperandDegraded", operatorv1.ConditionFalse, "AsExpected", ""),
+				condition("QueuesReady", operatorv1.ConditionFalse, "WaitingForKueue", "ignored"),
+			},
+			want: []*applyoperatorv1.OperatorConditionApplyConfiguration{
+				condition("Available", operatorv1.ConditionTrue, "AsExpected", "OperandAvailable: All 1 replicas are ready"),
+				condition("Progressing", operatorv1.ConditionFalse, "AsExpected", "All is well"),
+				condition("Degraded", operatorv1.ConditionFalse, "AsExpected", "All is well"),
+			},
+		},
+		"failing sub-controllers": {
+			conditions: []*applyoperatorv1.OperatorConditionApplyConfiguration{
+				condition("CertificatesProgressing", operatorv1.ConditionTrue, "WaitingForCertificates", "webhook-cert is not ready"),
+				condition("NetworkDegraded", operatorv1.ConditionTrue, "ApplyFailed", "forbidden"),
+				condition("OperandAvailable", operatorv1.ConditionFalse, "DeploymentNotFound", "not found"),
+				condition("WebhooksDegraded", operatorv1.ConditionTrue, "ApplyFailed", "conflict"),
+			},
+			want: []*applyoperatorv1.OperatorConditionApplyConfiguration{
+				condition("Available", operatorv1.ConditionFalse, "DeploymentNotFound", "OperandAvailable: not found"),
+				condition("Progressing", operatorv1.ConditionTrue, "WaitingForCertificates", "CertificatesProgressing: webhook-cert is not ready"),
+				condition("Degraded", operatorv1.ConditionTrue, "Network_ApplyFailed::Webhooks_ApplyFailed", "NetworkDegraded: forbidden\nWebhooksDegraded: conflict"),
+			},
+		},
+		"single unexpected conditions keep their reason": {
+			conditions: []*applyoperatorv1.OperatorConditionApplyConfiguration{
+				condition("DependenciesDegraded", operatorv1.ConditionTrue, "MissingDependencies", "cert-manager"),
+				condition("OperandAvailable", operatorv1.ConditionFalse, "UnsupportedTLSProfile", "TLS 1.0"),
+				condition("OperandDegraded", operatorv1.ConditionFalse, "AsExpected", ""),
+			},
+			want: []*applyoperatorv1.OperatorConditionApplyConfiguration{
+				condition("Available", operatorv1.ConditionFalse, "UnsupportedTLSProfile", "OperandAvailable: TLS 1.0"),
+				condition("Progressing", operatorv1.ConditionUnknown, "NoData", ""),
+				condition("Degraded", operatorv1.ConditionTrue, "MissingDependencies", "DependenciesDegraded: cert-manager"),
+			},
+		},
+		"no data": {
+			want: []*applyoperatorv1.OperatorConditionApplyConfiguration{
+				condition("Available", operatorv1.ConditionUnknown, "NoData", ""),
+				condition("Progressing", operatorv1.ConditionUnknown, "NoData", ""),
+				condition("Degraded", operatorv1.ConditionUnknown, "NoData", ""),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := Aggregate(tc.conditions)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("unexpected conditions (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
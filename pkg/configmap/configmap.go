@@ -18,6 +18,8 @@ package configmap
 
 import (
 	"fmt"
+	"maps"
+	"slices"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -29,8 +31,12 @@ import (
 	configapi "sigs.k8s.io/kueue/apis/config/v1beta2"
 
 	kueue "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+	"github.com/openshift/kueue-operator/pkg/integration"
 )
 
+// configKey is the key of the Kueue configuration in the ConfigMap.
+const configKey = "controller_manager_config.yaml"
+
 func BuildConfigMap(namespace string, kueueCfg kueue.KueueConfiguration, gvrToKind map[string]string, draSupported bool, tlsOpts *configapi.TLSOptions) (*corev1.ConfigMap, error) {
 	config := defaultKueueConfigurationTemplate(namespace, kueueCfg, gvrToKind, draSupported, tlsOpts)
 	cfg, err := yaml.Marshal(config)
@@ -42,7 +48,7 @@ func BuildConfigMap(namespace string, kueueCfg kueue.KueueConfiguration, gvrToKi
 			Name:      "kueue-manager-config",
 			Namespace: namespace,
 		},
-		Data: map[string]string{"controller_manager_config.yaml": string(cfg)},
+		Data: map[string]string{configKey: string(cfg)},
 	}
 	return cfgMap, nil
 }
@@ -56,36 +62,79 @@ func mapOperatorIntegrationsToKueue(integrations *kueue.Integrations) *configapi
 	}
 }
 
-func buildFrameworkList(kueuelist []kueue.KueueIntegration) []string {
-	// Upstream kueue uses lowercase names for these.
-	// This does not fit our api review so we are converted before building it into
-	// the configmap.
-	conversionMap := map[string]string{}
-	conversionMap[string(kueue.KueueIntegrationBatchJob)] = "batch/job"
-	conversionMap[string(kueue.KueueIntegrationMPIJob)] = "kubeflow.org/mpijob"
-	conversionMap[string(kueue.KueueIntegrationRayJob)] = "ray.io/rayjob"
-	conversionMap[string(kueue.KueueIntegrationRayCluster)] = "ray.io/raycluster"
-	conversionMap[string(kueue.KueueIntegrationRayService)] = "ray.io/rayservice"
-	conversionMap[string(kueue.KueueIntegrationJobSet)] = "jobset.x-k8s.io/jobset"
-	conversionMap[string(kueue.KueueIntegrationPaddleJob)] = "kubeflow.org/paddlejob"
-	conversionMap[string(kueue.KueueIntegrationPyTorchJob)] = "kubeflow.org/pytorchjob"
-	conversionMap[string(kueue.KueueIntegrationTFJob)] = "kubeflow.org/tfjob"
-	conversionMap[string(kueue.KueueIntegrationXGBoostJob)] = "kubeflow.org/xgboostjob"
-	conversionMap[string(kueue.KueueIntegrationAppWrapper)] = "workload.codeflare.dev/appwrapper"
-	conversionMap[string(kueue.KueueIntegrationPod)] = "pod"
-	conversionMap[string(kueue.KueueIntegrationDeployment)] = "deployment"
-	conversionMap[string(kueue.KueueIntegrationLeaderWorkerSet)] = "leaderworkerset.x-k8s.io/leaderworkerset"
-	conversionMap[string(kueue.KueueIntegrationStatefulSet)] = "statefulset"
-	conversionMap[string(kueue.KueueIntegrationTrainJob)] = "trainer.kubeflow.org/trainjob"
-	conversionMap[string(kueue.KueueIntegrationSparkApplication)] = "sparkoperator.k8s.io/sparkapplication"
+// frameworkNames are the names of the integrations in the Kueue configuration.
+// Upstream kueue uses lowercase names for these.
+// This does not fit our api review so we are converted before building it into
+// the configmap.
+var frameworkNames = map[kueue.KueueIntegration]string{
+	kueue.KueueIntegrationBatchJob:         "batch/job",
+	kueue.KueueIntegrationMPIJob:           "kubeflow.org/mpijob",
+	kueue.KueueIntegrationRayJob:           "ray.io/rayjob",
+	kueue.KueueIntegrationRayCluster:       "ray.io/raycluster",
+	kueue.KueueIntegrationRayService:       "ray.io/rayservice",
+	kueue.KueueIntegrationJobSet:           "jobset.x-k8s.io/jobset",
+	kueue.KueueIntegrationPaddleJob:        "kubeflow.org/paddlejob",
+	kueue.KueueIntegrationPyTorchJob:       "kubeflow.org/pytorchjob",
+	kueue.KueueIntegrationTFJob:            "kubeflow.org/tfjob",
+	kueue.KueueIntegrationXGBoostJob:       "kubeflow.org/xgboostjob",
+	kueue.KueueIntegrationAppWrapper:       "workload.codeflare.dev/appwrapper",
+	kueue.KueueIntegrationPod:              "pod",
+	kueue.KueueIntegrationDeployment:       "deployment",
+	kueue.KueueIntegrationLeaderWorkerSet:  "leaderworkerset.x-k8s.io/leaderworkerset",
+	kueue.KueueIntegrationStatefulSet:      "statefulset",
+	kueue.KueueIntegrationTrainJob:         "trainer.kubeflow.org/trainjob",
+	kueue.KueueIntegrationSparkApplication: "sparkoperator.k8s.io/sparkapplication",
+}
 
+func buildFrameworkList(kueuelist []kueue.KueueIntegration) []string {
 	ret := []string{}
 	for _, val := range kueuelist {
-		ret = append(ret, conversionMap[string(val)])
+		ret = append(ret, frameworkNames[val])
 	}
 	return ret
 }
 
+// Frameworks returns the integrations enabled by a ConfigMap built by BuildConfigMap.
+// The frameworks unknown to the operator are ignored.
+func Frameworks(cfgMap *corev1.ConfigMap) ([]kueue.KueueIntegration, error) {
+	config, err := parseConfiguration(cfgMap)
+	if err != nil {
+		return nil, err
+	}
+	if config.Integrations == nil {
+		return nil, nil
+	}
+	integrations := []kueue.KueueIntegration{}
+	for _, name := range config.Integrations.Frameworks {
+		for integration, frameworkName := range frameworkNames {
+			if frameworkName == name {
+				integrations = append(integrations, integration)
+			}
+		}
+	}
+	return integrations, nil
+}
+
+// LabelPolicy returns the label policy of a ConfigMap built by BuildConfigMap.
+func LabelPolicy(cfgMap *corev1.ConfigMap) (kueue.LabelPolicy, error) {
+	config, err := parseConfiguration(cfgMap)
+	if err != nil {
+		return "", err
+	}
+	if config.ManageJobsWithoutQueueName {
+		return kueue.LabelPolicyNone, nil
+	}
+	return kueue.LabelPolicyQueueName, nil
+}
+
+func parseConfiguration(cfgMap *corev1.ConfigMap) (*configapi.Configuration, error) {
+	config := &configapi.Configuration{}
+	if err := yaml.Unmarshal([]byte(cfgMap.Data[configKey]), config); err != nil {
+		return nil, fmt.Errorf("failed to parse the Kueue configuration of ConfigMap %s/%s: %w", cfgMap.Namespace, cfgMap.Name, err)
+	}
+	return config, nil
+}
+
 func buildExternalFrameworkList(kueuelist []kueue.ExternalFramework) []string {
 	ret := []string{}
 	for _, val := range kueuelist {
@@ -183,7 +232,7 @@ func buildResources(resources kueue.Resources) *configapi.Resources {
 	}
 }
 
-func buildFeatureGates(resources kueue.Resources, frameworks []kueue.KueueIntegration, draSupported bool) map[string]bool {
+func buildFeatureGates(resources kueue.Resources, frameworks []kueue.KueueIntegration, metrics kueue.Metrics, draSupported bool) map[string]bool {
 	featureGates := map[string]bool{}
 
 	// DynamicResourceAllocation is Alpha in Kueue, so we explicitly enable it
@@ -205,12 +254,45 @@ func buildFeatureGates(resources kueue.Resources, frameworks []kueue.KueueIntegr
 		}
 	}
 
+	// LocalQueueMetrics is Alpha in Kueue, so we explicitly enable it when the metrics of
+	// the LocalQueues are enabled.
+	if metrics.LocalQueueMetrics == kueue.MetricsStateEnabled {
+		featureGates["LocalQueueMetrics"] = true
+	}
+
 	if len(featureGates) == 0 {
 		return nil
 	}
 	return featureGates
 }
 
+// EffectiveConfiguration returns the configuration Kueue is deployed with by
+// BuildConfigMap, where the values left to the operator are resolved.
+func EffectiveConfiguration(kueueCfg kueue.KueueConfiguration, draSupported bool) kueue.EffectiveConfiguration {
+	effective := kueue.EffectiveConfiguration{
+		GangScheduling:   kueue.GangSchedulingPolicyNone,
+		PreemptionPolicy: kueue.PreemptionStrategyClassical,
+		LabelPolicy:      kueue.LabelPolicyQueueName,
+		Integrations:     integration.Enabled(kueueCfg.Integrations.Frameworks),
+	}
+	if waitForPodsReady := buildWaitForPodsReady(kueueCfg.GangScheduling); waitForPodsReady != nil {
+		effective.GangScheduling = kueue.GangSchedulingPolicyByWorkload
+		effective.GangSchedulingAdmission = kueue.GangSchedulingWorkloadAdmissionParallel
+		if ptr.Deref(waitForPodsReady.BlockAdmission, false) {
+			effective.GangSchedulingAdmission = kueue.GangSchedulingWorkloadAdmissionSequential
+		}
+	}
+	if buildFairSharing(kueueCfg.Preemption) != nil {
+		effective.PreemptionPolicy = kueue.PreemptionStrategyFairsharing
+	}
+	if buildManagedJobsWithoutQueueName(kueueCfg.WorkloadManagement) {
+		effective.LabelPolicy = kueue.LabelPolicyNone
+	}
+	featureGates := buildFeatureGates(kueueCfg.Resources, kueueCfg.Integrations.Frameworks, kueueCfg.Metrics, draSupported)
+	effective.FeatureGates = slices.Sorted(maps.Keys(featureGates))
+	return effective
+}
+
 func defaultKueueConfigurationTemplate(namespace string, kueueCfg kueue.KueueConfiguration, gvrToKind map[string]string, draSupported bool, tlsOpts *configapi.TLSOptions) *configapi.Configuration {
 	return &configapi.Configuration{
 		TypeMeta: v1.TypeMeta{
@@ -224,7 +306,7 @@ func defaultKueueConfigurationTemplate(namespace string, kueueCfg kueue.KueueCon
 			},
 			Metrics: configapi.ControllerMetrics{
 				BindAddress:                 ":8443",
-				EnableClusterQueueResources: true,
+				EnableClusterQueueResources: kueueCfg.Metrics.ClusterQueueResources != kueue.MetricsStateDisabled,
 			},
 			Webhook: configapi.ControllerWebhook{
 				Port: ptr.To(9443),
@@ -257,13 +339,13 @@ func defaultKueueConfigurationTemplate(namespace string, kueueCfg kueue.KueueCon
 			Enable: ptr.To(false),
 		},
 		ManagedJobsNamespaceSelector: &v1.LabelSelector{
-			MatchLabels: map[string]string{"kueue.openshift.io/managed": "true"},
+			MatchLabels: map[string]string{kueue.ManagedNamespaceLabel: "true"},
 		},
 		ManageJobsWithoutQueueName: buildManagedJobsWithoutQueueName(kueueCfg.WorkloadManagement),
 		WaitForPodsReady:           buildWaitForPodsReady(kueueCfg.GangScheduling),
 		FairSharing:                buildFairSharing(kueueCfg.Preemption),
 		Resources:                  buildResources(kueueCfg.Resources),
-		FeatureGates:               buildFeatureGates(kueueCfg.Resources, kueueCfg.Integrations.Frameworks, draSupported),
+		FeatureGates:               buildFeatureGates(kueueCfg.Resources, kueueCfg.Integrations.Frameworks, kueueCfg.Metrics, draSupported),
 		MultiKueue:                 mapOperatorMultiKueueToKueue(kueueCfg.MultiKueue, gvrToKind),
 	}
 }
@@ -374,6 +374,62 @@ metrics:
   bindAddress: :8443
   enableClusterQueueResources: true
 namespace: test
+webhook:
+  port: 9443
+`,
+				},
+			},
+			wantErr: nil,
+		},
+		"local queue metrics without cluster queue resources": {
+			configuration: kueue.KueueConfiguration{
+				Integrations: kueue.Integrations{
+					Frameworks: []kueue.KueueIntegration{kueue.KueueIntegrationBatchJob},
+				},
+				Metrics: kueue.Metrics{
+					LocalQueueMetrics:     kueue.MetricsStateEnabled,
+					ClusterQueueResources: kueue.MetricsStateDisabled,
+				},
+			},
+			wantCfgMap: &corev1.ConfigMap{
+				Data: map[string]string{
+					"controller_manager_config.yaml": `apiVersion: config.kueue.x-k8s.io/v1beta2
+clientConnection:
+  burst: 100
+  qps: 50
+controller:
+  groupKindConcurrency:
+    ClusterQueue.kueue.x-k8s.io: 1
+    Job.batch: 5
+    LocalQueue.kueue.x-k8s.io: 1
+    Pod: 5
+    ResourceFlavor.kueue.x-k8s.io: 1
+    Workload.kueue.x-k8s.io: 5
+featureGates:
+  LocalQueueMetrics: true
+health:
+  healthProbeBindAddress: :8081
+integrations:
+  frameworks:
+  - batch/job
+internalCertManagement:
+  enable: false
+kind: Configuration
+leaderElection:
+  leaderElect: true
+  leaseDuration: 2m17s
+  renewDeadline: 1m47s
+  resourceLock: ""
+  resourceName: ""
+  resourceNamespace: ""
+  retryPeriod: 26s
+manageJobsWithoutQueueName: false
+managedJobsNamespaceSelector:
+  matchLabels:
+    kueue.openshift.io/managed: "true"
+metrics:
+  bindAddress: :8443
+namespace: test
 webhook:
   port: 9443
 `,
@@ -803,3 +859,112 @@ webhook:
 		})
 	}
 }
+
+func TestFrameworks(t *testing.T) {
+	frameworks := []kueue.KueueIntegration{kueue.KueueIntegrationRayJob, kueue.KueueIntegrationBatchJob, kueue.KueueIntegrationPod}
+	cfgMap, err := BuildConfigMap("test", kueue.KueueConfiguration{
+		Integrations: kueue.Integrations{Frameworks: frameworks},
+	}, nil, false, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := Frameworks(cfgMap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(frameworks, got); diff != "" {
+		t.Errorf("Unexpected frameworks (-want,+got):\n%s", diff)
+	}
+
+	if _, err := Frameworks(&corev1.ConfigMap{Data: map[string]string{configKey: "integrations: ["}}); err == nil {
+		t.Errorf("Expected an error for an invalid configuration")
+	}
+}
+
+func TestLabelPolicy(t *testing.T) {
+	for _, policy := range []kueue.LabelPolicy{kueue.LabelPolicyQueueName, kueue.LabelPolicyNone} {
+		cfgMap, err := BuildConfigMap("test", kueue.KueueConfiguration{
+			WorkloadManagement: kueue.WorkloadManagement{LabelPolicy: policy},
+		}, nil, false, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := LabelPolicy(cfgMap)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != policy {
+			t.Errorf("LabelPolicy() = %s, want %s", got, policy)
+		}
+	}
+}
+
+func TestEffectiveConfiguration(t *testing.T) {
+	testCases := map[string]struct {
+		configuration kueue.KueueConfiguration
+		draSupported  bool
+		want          kueue.EffectiveConfiguration
+	}{
+		"defaults": {
+			configuration: kueue.KueueConfiguration{
+				Integrations: kueue.Integrations{
+					Frameworks: []kueue.KueueIntegration{kueue.KueueIntegrationBatchJob},
+				},
+			},
+			want: kueue.EffectiveConfiguration{
+				GangScheduling:   kueue.GangSchedulingPolicyNone,
+				PreemptionPolicy: kueue.PreemptionStrategyClassical,
+				LabelPolicy:      kueue.LabelPolicyQueueName,
+				Integrations:     []kueue.KueueIntegration{kueue.KueueIntegrationBatchJob},
+			},
+		},
+		"resolved policies, implicit pod and feature gates": {
+			configuration: kueue.KueueConfiguration{
+				Integrations: kueue.Integrations{
+					Frameworks: []kueue.KueueIntegration{kueue.KueueIntegrationSparkApplication, kueue.KueueIntegrationDeployment},
+				},
+				WorkloadManagement: kueue.WorkloadManagement{LabelPolicy: kueue.LabelPolicyNone},
+				GangScheduling: kueue.GangScheduling{
+					Policy:     kueue.GangSchedulingPolicyByWorkload,
+					ByWorkload: &kueue.ByWorkload{Admission: kueue.GangSchedulingWorkloadAdmissionSequential},
+				},
+				Preemption: kueue.Preemption{PreemptionPolicy: kueue.PreemptionStrategyFairsharing},
+				Resources: kueue.Resources{
+					DeviceClassMappings: []kueue.DeviceClassMapping{{Name: "example.com/gpu", DeviceClassNames: []kueue.DeviceClassName{"gpu.example.com"}}},
+				},
+				Metrics: kueue.Metrics{LocalQueueMetrics: kueue.MetricsStateEnabled},
+			},
+			draSupported: true,
+			want: kueue.EffectiveConfiguration{
+				GangScheduling:          kueue.GangSchedulingPolicyByWorkload,
+				GangSchedulingAdmission: kueue.GangSchedulingWorkloadAdmissionSequential,
+				PreemptionPolicy:        kueue.PreemptionStrategyFairsharing,
+				LabelPolicy:             kueue.LabelPolicyNone,
+				Integrations:            []kueue.KueueIntegration{kueue.KueueIntegrationSparkApplication, kueue.KueueIntegrationDeployment, kueue.KueueIntegrationPod},
+				FeatureGates:            []string{"DynamicResourceAllocation", "LocalQueueMetrics", "SparkApplicationIntegration"},
+			},
+		},
+		"parallel gang admission": {
+			configuration: kueue.KueueConfiguration{
+				GangScheduling: kueue.GangScheduling{
+					Policy:     kueue.GangSchedulingPolicyByWorkload,
+					ByWorkload: &kueue.ByWorkload{Admission: kueue.GangSchedulingWorkloadAdmissionParallel},
+				},
+			},
+			want: kueue.EffectiveConfiguration{
+				GangScheduling:          kueue.GangSchedulingPolicyByWorkload,
+				GangSchedulingAdmission: kueue.GangSchedulingWorkloadAdmissionParallel,
+				PreemptionPolicy:        kueue.PreemptionStrategyClassical,
+				LabelPolicy:             kueue.LabelPolicyQueueName,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := EffectiveConfiguration(tc.configuration, tc.draSupported)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Unexpected effective configuration (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
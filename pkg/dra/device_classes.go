@@ -0,0 +1,152 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package dra derives and validates the DeviceClass mappings Kueue uses to
+// account for Dynamic Resource Allocation devices in ClusterQueue quotas.
+package dra
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	kueue "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+	resourcev1 "k8s.io/api/resource/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// ResourceNameLabel is the DeviceClass label holding the Kueue resource name the
+// DeviceClass is mapped to by auto discovery.
+const ResourceNameLabel = "kueue.openshift.io/resource-name"
+
+const (
+	// maxMappings and maxDeviceClassNames mirror the limits of the Kueue API.
+	maxMappings         = 16
+	maxDeviceClassNames = 8
+)
+
+// driverExpression matches the driver selected by a DeviceClass CEL selector,
+// e.g. device.driver == "gpu.nvidia.com".
+var driverExpression = regexp.MustCompile(`device\.driver\s*==\s*["']([^"']+)["']`)
+
+// ResourceName returns the Kueue resource name the DeviceClass is mapped to: the value
+// of its ResourceNameLabel, or else the DRA driver selected by the DeviceClass.
+// It returns false when neither is set, or the result is not a valid resource name.
+func ResourceName(deviceClass *resourcev1.DeviceClass) (string, bool) {
+	name := deviceClass.Labels[ResourceNameLabel]
+	if name == "" {
+		for _, selector := range deviceClass.Spec.Selectors {
+			if selector.CEL == nil {
+				continue
+			}
+			if match := driverExpression.FindStringSubmatch(selector.CEL.Expression); match != nil {
+				name = match[1]
+				break
+			}
+		}
+	}
+	if name == "" || len(validation.IsQualifiedName(name)) > 0 {
+		return "", false
+	}
+	return name, true
+}
+
+// DiscoverMappings groups the DeviceClasses by the resource name they are mapped to.
+// DeviceClasses that are already part of a configured mapping are skipped.
+// The result is sorted by resource name and truncated to the limits of the Kueue API.
+func DiscoverMappings(deviceClasses []*resourcev1.DeviceClass, configured []kueue.DeviceClassMapping) []kueue.DeviceClassMapping {
+	mapped := mappedDeviceClasses(configured)
+	byName := map[string][]kueue.DeviceClassName{}
+	for _, deviceClass := range deviceClasses {
+		if mapped[deviceClass.Name] {
+			continue
+		}
+		name, ok := ResourceName(deviceClass)
+		if !ok {
+			continue
+		}
+		byName[name] = append(byName[name], kueue.DeviceClassName(deviceClass.Name))
+	}
+
+	var mappings []kueue.DeviceClassMapping
+	for name, classNames := range byName {
+		slices.Sort(classNames)
+		if len(classNames) > maxDeviceClassNames {
+			classNames = classNames[:maxDeviceClassNames]
+		}
+		mappings = append(mappings, kueue.DeviceClassMapping{Name: name, DeviceClassNames: classNames})
+	}
+	slices.SortFunc(mappings, func(a, b kueue.DeviceClassMapping) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	if len(mappings) > maxMappings {
+		mappings = mappings[:maxMappings]
+	}
+	return mappings
+}
+
+// MergeMappings returns the configured mappings followed by the discovered ones.
+// Discovered DeviceClasses mapped to a configured resource name are added to that mapping.
+// The result is truncated to the limits of the Kueue API, the configured mappings and
+// DeviceClasses come first. The configured mappings are not modified.
+func MergeMappings(configured, discovered []kueue.DeviceClassMapping) []kueue.DeviceClassMapping {
+	if len(discovered) == 0 {
+		return configured
+	}
+	merged := make([]kueue.DeviceClassMapping, 0, len(configured)+len(discovered))
+	for _, mapping := range configured {
+		merged = append(merged, kueue.DeviceClassMapping{
+			Name:             mapping.Name,
+			DeviceClassNames: slices.Clone(mapping.DeviceClassNames),
+		})
+	}
+	for _, mapping := range discovered {
+		i := slices.IndexFunc(merged, func(m kueue.DeviceClassMapping) bool { return m.Name == mapping.Name })
+		if i < 0 {
+			if len(merged) < maxMappings {
+				merged = append(merged, mapping)
+			}
+			continue
+		}
+		names := append(merged[i].DeviceClassNames, mapping.DeviceClassNames...)
+		merged[i].DeviceClassNames = names[:min(len(names), maxDeviceClassNames)]
+	}
+	return merged
+}
+
+// MissingDeviceClasses returns the DeviceClass names referenced by the mappings for
+// which exists returns false, in the order they are referenced.
+func MissingDeviceClasses(mappings []kueue.DeviceClassMapping, exists func(name string) bool) []string {
+	missing := []string{}
+	for _, mapping := range mappings {
+		for _, name := range mapping.DeviceClassNames {
+			if !exists(string(name)) && !slices.Contains(missing, string(name)) {
+				missing = append(missing, string(name))
+			}
+		}
+	}
+	return missing
+}
+
+func mappedDeviceClasses(mappings []kueue.DeviceClassMapping) map[string]bool {
+	mapped := map[string]bool{}
+	for _, mapping := range mappings {
+		for _, name := range mapping.DeviceClassNames {
+			mapped[string(name)] = true
+		}
+	}
+	return mapped
+}
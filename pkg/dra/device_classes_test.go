@@ -0,0 +1,178 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dra
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	resourcev1 "k8s.io/api/resource/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kueue "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+)
+
+func deviceClass(name string, labels map[string]string, expressions ...string) *resourcev1.DeviceClass {
+	dc := &resourcev1.DeviceClass{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+	}
+	for _, expression := range expressions {
+		dc.Spec.Selectors = append(dc.Spec.Selectors, resourcev1.DeviceSelector{
+			CEL: &resourcev1.CELDeviceSelector{Expression: expression},
+		})
+	}
+	return dc
+}
+
+func TestResourceName(t *testing.T) {
+	testCases := map[string]struct {
+		deviceClass *resourcev1.DeviceClass
+		want        string
+		wantOK      bool
+	}{
+		"label takes precedence over the driver": {
+			deviceClass: deviceClass("gpu.nvidia.com", map[string]string{ResourceNameLabel: "nvidia.com.gpu"}, `device.driver == "gpu.nvidia.com"`),
+			want:        "nvidia.com.gpu",
+			wantOK:      true,
+		},
+		"driver from a CEL selector": {
+			deviceClass: deviceClass("mig-1g", nil, `device.attributes["gpu.nvidia.com"].type == "mig"`, `device.driver == 'gpu.nvidia.com' && device.attributes["gpu.nvidia.com"].profile == "1g.5gb"`),
+			want:        "gpu.nvidia.com",
+			wantOK:      true,
+		},
+		"no label and no driver": {
+			deviceClass: deviceClass("anything", nil, `device.capacity["example.com"].memory.compareTo(quantity("1Gi")) >= 0`),
+		},
+		"invalid resource name in label": {
+			deviceClass: deviceClass("invalid", map[string]string{ResourceNameLabel: "-invalid-"}),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, ok := ResourceName(tc.deviceClass)
+			if got != tc.want || ok != tc.wantOK {
+				t.Errorf("ResourceName() = %q, %v, want %q, %v", got, ok, tc.want, tc.wantOK)
+			}
+		})
+	}
+}
+
+func TestDiscoverMappings(t *testing.T) {
+	deviceClasses := []*resourcev1.DeviceClass{
+		deviceClass("gpu.nvidia.com", nil, `device.driver == "gpu.nvidia.com"`),
+		deviceClass("mig.nvidia.com", nil, `device.driver == "gpu.nvidia.com"`),
+		deviceClass("fpga.example.com", map[string]string{ResourceNameLabel: "example.com/fpga"}),
+		deviceClass("unmapped", nil),
+	}
+
+	testCases := map[string]struct {
+		configured []kueue.DeviceClassMapping
+		want       []kueue.DeviceClassMapping
+	}{
+		"all DeviceClasses are discovered": {
+			want: []kueue.DeviceClassMapping{
+				{Name: "example.com/fpga", DeviceClassNames: []kueue.DeviceClassName{"fpga.example.com"}},
+				{Name: "gpu.nvidia.com", DeviceClassNames: []kueue.DeviceClassName{"gpu.nvidia.com", "mig.nvidia.com"}},
+			},
+		},
+		"configured DeviceClasses are skipped": {
+			configured: []kueue.DeviceClassMapping{
+				{Name: "nvidia.com/gpu", DeviceClassNames: []kueue.DeviceClassName{"gpu.nvidia.com"}},
+			},
+			want: []kueue.DeviceClassMapping{
+				{Name: "example.com/fpga", DeviceClassNames: []kueue.DeviceClassName{"fpga.example.com"}},
+				{Name: "gpu.nvidia.com", DeviceClassNames: []kueue.DeviceClassName{"mig.nvidia.com"}},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := DiscoverMappings(deviceClasses, tc.configured)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("unexpected mappings (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMergeMappings(t *testing.T) {
+	configured := []kueue.DeviceClassMapping{
+		{Name: "nvidia.com/gpu", DeviceClassNames: []kueue.DeviceClassName{"gpu.nvidia.com"}},
+	}
+	discovered := []kueue.DeviceClassMapping{
+		{Name: "example.com/fpga", DeviceClassNames: []kueue.DeviceClassName{"fpga.example.com"}},
+		{Name: "nvidia.com/gpu", DeviceClassNames: []kueue.DeviceClassName{"mig.nvidia.com"}},
+	}
+
+	got := MergeMappings(configured, discovered)
+	want := []kueue.DeviceClassMapping{
+		{Name: "nvidia.com/gpu", DeviceClassNames: []kueue.DeviceClassName{"gpu.nvidia.com", "mig.nvidia.com"}},
+		{Name: "example.com/fpga", DeviceClassNames: []kueue.DeviceClassName{"fpga.example.com"}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected mappings (-want,+got):\n%s", diff)
+	}
+	if len(configured[0].DeviceClassNames) != 1 {
+		t.Errorf("the configured mappings were modified: %v", configured)
+	}
+}
+
+func TestMergeMappingsLimits(t *testing.T) {
+	configured := []kueue.DeviceClassMapping{
+		{Name: "nvidia.com/gpu", DeviceClassNames: []kueue.DeviceClassName{"a", "b", "c", "d", "e", "f"}},
+	}
+	for i := range maxMappings - 2 {
+		configured = append(configured, kueue.DeviceClassMapping{
+			Name:             fmt.Sprintf("example.com/configured-%d", i),
+			DeviceClassNames: []kueue.DeviceClassName{kueue.DeviceClassName(fmt.Sprintf("configured-%d", i))},
+		})
+	}
+	discovered := []kueue.DeviceClassMapping{
+		{Name: "example.com/first", DeviceClassNames: []kueue.DeviceClassName{"first"}},
+		{Name: "example.com/second", DeviceClassNames: []kueue.DeviceClassName{"second"}},
+		{Name: "nvidia.com/gpu", DeviceClassNames: []kueue.DeviceClassName{"g", "h", "i"}},
+	}
+
+	got := MergeMappings(configured, discovered)
+	if len(got) != maxMappings {
+		t.Fatalf("expected %d mappings, got %d", maxMappings, len(got))
+	}
+	if got[maxMappings-1].Name != "example.com/first" {
+		t.Errorf("expected the first discovered mapping to be kept, got %q", got[maxMappings-1].Name)
+	}
+	want := []kueue.DeviceClassName{"a", "b", "c", "d", "e", "f", "g", "h"}
+	if diff := cmp.Diff(want, got[0].DeviceClassNames); diff != "" {
+		t.Errorf("unexpected DeviceClass names (-want,+got):\n%s", diff)
+	}
+}
+
+func TestMissingDeviceClasses(t *testing.T) {
+	mappings := []kueue.DeviceClassMapping{
+		{Name: "nvidia.com/gpu", DeviceClassNames: []kueue.DeviceClassName{"gpu.nvidia.com", "missing.nvidia.com"}},
+		{Name: "example.com/fpga", DeviceClassNames: []kueue.DeviceClassName{"missing.example.com", "missing.nvidia.com"}},
+	}
+	existing := map[string]bool{"gpu.nvidia.com": true}
+
+	got := MissingDeviceClasses(mappings, func(name string) bool { return existing[name] })
+	want := []string{"missing.nvidia.com", "missing.example.com"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected missing DeviceClasses (-want,+got):\n%s", diff)
+	}
+}
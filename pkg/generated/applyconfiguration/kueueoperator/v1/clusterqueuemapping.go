@@ -0,0 +1,58 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// ClusterQueueMappingApplyConfiguration represents a declarative configuration of the ClusterQueueMapping type for use
+// with apply.
+//
+// ClusterQueueMapping maps the namespaces with a set of labels to a ClusterQueue.
+type ClusterQueueMappingApplyConfiguration struct {
+	// namespaceLabels are the labels a namespace must have for the mapping to apply.
+	// namespaceLabels must have at least one entry and no more than 8 entries.
+	NamespaceLabels map[string]string `json:"namespaceLabels,omitempty"`
+	// clusterQueue is the name of the ClusterQueue the default LocalQueue points to.
+	ClusterQueue *string `json:"clusterQueue,omitempty"`
+}
+
+// ClusterQueueMappingApplyConfiguration constructs a declarative configuration of the ClusterQueueMapping type for use with
+// apply.
+func ClusterQueueMapping() *ClusterQueueMappingApplyConfiguration {
+	return &ClusterQueueMappingApplyConfiguration{}
+}
+
+// WithNamespaceLabels puts the entries into the NamespaceLabels field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the NamespaceLabels field,
+// overwriting an existing map entries in NamespaceLabels field with the same key.
+func (b *ClusterQueueMappingApplyConfiguration) WithNamespaceLabels(entries map[string]string) *ClusterQueueMappingApplyConfiguration {
+	if b.NamespaceLabels == nil && len(entries) > 0 {
+		b.NamespaceLabels = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.NamespaceLabels[k] = v
+	}
+	return b
+}
+
+// WithClusterQueue sets the ClusterQueue field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClusterQueue field is set to the value of the last call.
+func (b *ClusterQueueMappingApplyConfiguration) WithClusterQueue(value string) *ClusterQueueMappingApplyConfiguration {
+	b.ClusterQueue = &value
+	return b
+}
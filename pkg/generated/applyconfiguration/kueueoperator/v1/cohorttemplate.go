@@ -0,0 +1,54 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// CohortTemplateApplyConfiguration represents a declarative configuration of the CohortTemplate type for use
+// with apply.
+//
+// CohortTemplate describes a Cohort created by the operator.
+type CohortTemplateApplyConfiguration struct {
+	// name is the name of the Cohort.
+	// Must be a valid DNS 1123 label consisting of lower-case alphanumeric characters
+	// and hyphens, of at most 63 characters in length.
+	Name *string `json:"name,omitempty"`
+	// parentName is the name of the parent Cohort.
+	// When omitted, the Cohort is a root Cohort.
+	ParentName *string `json:"parentName,omitempty"`
+}
+
+// CohortTemplateApplyConfiguration constructs a declarative configuration of the CohortTemplate type for use with
+// apply.
+func CohortTemplate() *CohortTemplateApplyConfiguration {
+	return &CohortTemplateApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *CohortTemplateApplyConfiguration) WithName(value string) *CohortTemplateApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithParentName sets the ParentName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ParentName field is set to the value of the last call.
+func (b *CohortTemplateApplyConfiguration) WithParentName(value string) *CohortTemplateApplyConfiguration {
+	b.ParentName = &value
+	return b
+}
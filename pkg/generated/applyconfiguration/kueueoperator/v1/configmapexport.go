@@ -0,0 +1,61 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// ConfigMapExportApplyConfiguration represents a declarative configuration of the ConfigMapExport type for use
+// with apply.
+//
+// ConfigMapExport exports the Kueue custom resources to ConfigMaps.
+// The resources are written as YAML documents to the ConfigMaps named after name,
+// suffixed with their index, and labeled with kueue.openshift.io/export=<name>.
+// The ConfigMap named name lists them, and is written last, once the export is complete.
+// ConfigMaps are limited to 1MiB, so the export is split over as many ConfigMaps as needed.
+type ConfigMapExportApplyConfiguration struct {
+	// namespace is the namespace of the ConfigMaps.
+	// It should not be the namespace of the operator, which may be deleted with it.
+	// Must be a valid DNS 1123 label consisting of lower-case alphanumeric characters
+	// and hyphens, of at most 63 characters in length.
+	Namespace *string `json:"namespace,omitempty"`
+	// name is the name of the ConfigMap listing the export.
+	// Must be a valid DNS 1123 label consisting of lower-case alphanumeric characters
+	// and hyphens, of at most 56 characters in length, leaving room for the index
+	// suffix of the other ConfigMaps.
+	Name *string `json:"name,omitempty"`
+}
+
+// ConfigMapExportApplyConfiguration constructs a declarative configuration of the ConfigMapExport type for use with
+// apply.
+func ConfigMapExport() *ConfigMapExportApplyConfiguration {
+	return &ConfigMapExportApplyConfiguration{}
+}
+
+// WithNamespace sets the Namespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Namespace field is set to the value of the last call.
+func (b *ConfigMapExportApplyConfiguration) WithNamespace(value string) *ConfigMapExportApplyConfiguration {
+	b.Namespace = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *ConfigMapExportApplyConfiguration) WithName(value string) *ConfigMapExportApplyConfiguration {
+	b.Name = &value
+	return b
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// ContainerDefaultsApplyConfiguration represents a declarative configuration of the ContainerDefaults type for use
+// with apply.
+//
+// ContainerDefaults are the default requests and limits of the containers of managed
+// namespaces.
+type ContainerDefaultsApplyConfiguration struct {
+	// resources are the defaults of each resource.
+	// resources must have at least one item and no more than 16 items.
+	Resources []ContainerResourceDefaultApplyConfiguration `json:"resources,omitempty"`
+}
+
+// ContainerDefaultsApplyConfiguration constructs a declarative configuration of the ContainerDefaults type for use with
+// apply.
+func ContainerDefaults() *ContainerDefaultsApplyConfiguration {
+	return &ContainerDefaultsApplyConfiguration{}
+}
+
+// WithResources adds the given value to the Resources field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Resources field.
+func (b *ContainerDefaultsApplyConfiguration) WithResources(values ...*ContainerResourceDefaultApplyConfiguration) *ContainerDefaultsApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithResources")
+		}
+		b.Resources = append(b.Resources, *values[i])
+	}
+	return b
+}
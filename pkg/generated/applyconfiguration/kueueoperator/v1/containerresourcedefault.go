@@ -0,0 +1,68 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	resource "k8s.io/apimachinery/pkg/api/resource"
+)
+
+// ContainerResourceDefaultApplyConfiguration represents a declarative configuration of the ContainerResourceDefault type for use
+// with apply.
+//
+// ContainerResourceDefault is the default request and limit of a resource.
+type ContainerResourceDefaultApplyConfiguration struct {
+	// name is the name of the resource, e.g. cpu, memory or nvidia.com/gpu.
+	Name *string `json:"name,omitempty"`
+	// defaultRequest is the request set on the containers that do not request the
+	// resource. It must not be greater than defaultLimit.
+	// When omitted, Kubernetes uses defaultLimit, if set.
+	DefaultRequest *resource.Quantity `json:"defaultRequest,omitempty"`
+	// defaultLimit is the limit set on the containers that do not limit the resource.
+	// When omitted, containers are not limited by default.
+	DefaultLimit *resource.Quantity `json:"defaultLimit,omitempty"`
+}
+
+// ContainerResourceDefaultApplyConfiguration constructs a declarative configuration of the ContainerResourceDefault type for use with
+// apply.
+func ContainerResourceDefault() *ContainerResourceDefaultApplyConfiguration {
+	return &ContainerResourceDefaultApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *ContainerResourceDefaultApplyConfiguration) WithName(value string) *ContainerResourceDefaultApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithDefaultRequest sets the DefaultRequest field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DefaultRequest field is set to the value of the last call.
+func (b *ContainerResourceDefaultApplyConfiguration) WithDefaultRequest(value resource.Quantity) *ContainerResourceDefaultApplyConfiguration {
+	b.DefaultRequest = &value
+	return b
+}
+
+// WithDefaultLimit sets the DefaultLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DefaultLimit field is set to the value of the last call.
+func (b *ContainerResourceDefaultApplyConfiguration) WithDefaultLimit(value resource.Quantity) *ContainerResourceDefaultApplyConfiguration {
+	b.DefaultLimit = &value
+	return b
+}
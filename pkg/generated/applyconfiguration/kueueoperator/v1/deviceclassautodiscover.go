@@ -0,0 +1,56 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	kueueoperatorv1 "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+)
+
+// DeviceClassAutoDiscoverApplyConfiguration represents a declarative configuration of the DeviceClassAutoDiscover type for use
+// with apply.
+//
+// DeviceClassAutoDiscover configures the discovery of DeviceClass mappings.
+type DeviceClassAutoDiscoverApplyConfiguration struct {
+	// mode controls whether DeviceClass mappings are discovered, and what is
+	// done with them.
+	// The allowed values are Disabled, Propose, Generate and "".
+	// When set to Disabled, no mappings are discovered.
+	// When set to Propose, the discovered mappings are reported in
+	// status.discoveredDeviceClassMappings so they can be reviewed and copied
+	// to deviceClassMappings.
+	// When set to Generate, the discovered mappings are also reported, and are
+	// added to the Kueue configuration together with deviceClassMappings.
+	// When set to "", this means no opinion and the operator is left
+	// to choose a reasonable default, which is subject to change over time.
+	// The current default is Disabled.
+	Mode *kueueoperatorv1.DeviceClassAutoDiscoverMode `json:"mode,omitempty"`
+}
+
+// DeviceClassAutoDiscoverApplyConfiguration constructs a declarative configuration of the DeviceClassAutoDiscover type for use with
+// apply.
+func DeviceClassAutoDiscover() *DeviceClassAutoDiscoverApplyConfiguration {
+	return &DeviceClassAutoDiscoverApplyConfiguration{}
+}
+
+// WithMode sets the Mode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Mode field is set to the value of the last call.
+func (b *DeviceClassAutoDiscoverApplyConfiguration) WithMode(value kueueoperatorv1.DeviceClassAutoDiscoverMode) *DeviceClassAutoDiscoverApplyConfiguration {
+	b.Mode = &value
+	return b
+}
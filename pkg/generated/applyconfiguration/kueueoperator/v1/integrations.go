@@ -45,6 +45,39 @@ type IntegrationsApplyConfiguration struct {
 	// If not specified, only the Kueue labels will be copied.
 	// labelKeysToCopy, if specified, is limited to a maximum of 64 items.
 	LabelKeysToCopy []LabelKeysApplyConfiguration `json:"labelKeysToCopy,omitempty"`
+	// missingDependencyPolicy controls what the operator does when the API
+	// required by an enabled framework is not installed on the cluster, or is
+	// not served in the version Kueue requires.
+	// The allowed values are Degrade, Skip and "".
+	// When set to Degrade, the framework is kept in the Kueue configuration and
+	// the operator reports a Degraded condition until the API becomes available.
+	// When set to Skip, the framework is left out of the Kueue configuration
+	// until the API becomes available, and the remaining frameworks are deployed.
+	// When set to "", this means no opinion and the operator is left
+	// to choose a reasonable default, which is subject to change over time.
+	// The current default is Degrade.
+	MissingDependencyPolicy *kueueoperatorv1.MissingDependencyPolicy `json:"missingDependencyPolicy,omitempty"`
+	// skipIncompatibleFrameworks are frameworks that are left out of the Kueue
+	// configuration when their API is installed in a version that is not supported
+	// by the Kueue release deployed by the operator, instead of degrading the operator.
+	// The supported and served versions of each framework are reported in
+	// status.integrations.
+	// skipIncompatibleFrameworks, if specified, can not have more than 18 items.
+	SkipIncompatibleFrameworks []kueueoperatorv1.KueueIntegration `json:"skipIncompatibleFrameworks,omitempty"`
+	// removedFrameworksPolicy controls what the operator does with the workloads of
+	// the suspendable frameworks, such as BatchJob or RayJob, that are removed from
+	// the Kueue configuration, either from frameworks or because their API is not
+	// available anymore. Kueue does not manage them anymore: the jobs it suspended stay
+	// suspended, and their Workloads keep holding quota.
+	// The allowed values are Report, Release and "".
+	// When set to Report, the remaining Workloads are reported in the
+	// IntegrationRemovalProgressing condition until they are deleted.
+	// When set to Release, once Kueue runs without the removed frameworks, the jobs
+	// waiting for admission are unsuspended and the Workloads are deleted.
+	// When set to "", this means no opinion and the operator is left
+	// to choose a reasonable default, which is subject to change over time.
+	// The current default is Report.
+	RemovedFrameworksPolicy *kueueoperatorv1.RemovedFrameworksPolicy `json:"removedFrameworksPolicy,omitempty"`
 }
 
 // IntegrationsApplyConfiguration constructs a declarative configuration of the Integrations type for use with
@@ -88,3 +121,29 @@ func (b *IntegrationsApplyConfiguration) WithLabelKeysToCopy(values ...*LabelKey
 	}
 	return b
 }
+
+// WithMissingDependencyPolicy sets the MissingDependencyPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MissingDependencyPolicy field is set to the value of the last call.
+func (b *IntegrationsApplyConfiguration) WithMissingDependencyPolicy(value kueueoperatorv1.MissingDependencyPolicy) *IntegrationsApplyConfiguration {
+	b.MissingDependencyPolicy = &value
+	return b
+}
+
+// WithSkipIncompatibleFrameworks adds the given value to the SkipIncompatibleFrameworks field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the SkipIncompatibleFrameworks field.
+func (b *IntegrationsApplyConfiguration) WithSkipIncompatibleFrameworks(values ...kueueoperatorv1.KueueIntegration) *IntegrationsApplyConfiguration {
+	for i := range values {
+		b.SkipIncompatibleFrameworks = append(b.SkipIncompatibleFrameworks, values[i])
+	}
+	return b
+}
+
+// WithRemovedFrameworksPolicy sets the RemovedFrameworksPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RemovedFrameworksPolicy field is set to the value of the last call.
+func (b *IntegrationsApplyConfiguration) WithRemovedFrameworksPolicy(value kueueoperatorv1.RemovedFrameworksPolicy) *IntegrationsApplyConfiguration {
+	b.RemovedFrameworksPolicy = &value
+	return b
+}
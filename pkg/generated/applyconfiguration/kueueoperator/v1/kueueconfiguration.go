@@ -56,6 +56,16 @@ type KueueConfigurationApplyConfiguration struct {
 	// This field is optional.
 	// If multiKueue is not specified, MultiKueue is disabled.
 	MultiKueue *MultiKueueApplyConfiguration `json:"multiKueue,omitempty"`
+	// rbac configures the ClusterRoles the operator installs for Kueue.
+	// The editor and viewer ClusterRoles of an integration are only installed
+	// when the integration is enabled.
+	// This field is optional.
+	RBAC *RBACApplyConfiguration `json:"rbac,omitempty"`
+	// metrics configures the metrics Kueue reports and how they are scraped.
+	// This field is optional.
+	// If metrics is not specified, the operator will decide the defaults.
+	// These defaults could change over time.
+	Metrics *MetricsApplyConfiguration `json:"metrics,omitempty"`
 }
 
 // KueueConfigurationApplyConfiguration constructs a declarative configuration of the KueueConfiguration type for use with
@@ -111,3 +121,19 @@ func (b *KueueConfigurationApplyConfiguration) WithMultiKueue(value *MultiKueueA
 	b.MultiKueue = value
 	return b
 }
+
+// WithRBAC sets the RBAC field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RBAC field is set to the value of the last call.
+func (b *KueueConfigurationApplyConfiguration) WithRBAC(value *RBACApplyConfiguration) *KueueConfigurationApplyConfiguration {
+	b.RBAC = value
+	return b
+}
+
+// WithMetrics sets the Metrics field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Metrics field is set to the value of the last call.
+func (b *KueueConfigurationApplyConfiguration) WithMetrics(value *MetricsApplyConfiguration) *KueueConfigurationApplyConfiguration {
+	b.Metrics = value
+	return b
+}
@@ -20,6 +20,7 @@ package v1
 import (
 	apioperatorv1 "github.com/openshift/api/operator/v1"
 	operatorv1 "github.com/openshift/client-go/operator/applyconfigurations/operator/v1"
+	kueueoperatorv1 "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -30,6 +31,67 @@ type KueueOperandSpecApplyConfiguration struct {
 	// config is the desired configuration
 	// for the Kueue operator.
 	Config *KueueConfigurationApplyConfiguration `json:"config,omitempty"`
+	// queues declares the ResourceFlavors, Cohorts and ClusterQueues the operator
+	// creates for Kueue, and the LocalQueues it creates in every namespace labeled
+	// with kueue.openshift.io/managed=true.
+	// The operator labels the objects it creates with
+	// kueue.openshift.io/managed-by=kueue-operator, keeps them in sync with this
+	// section, and deletes them when they are removed from it.
+	// Objects with the same name that were not created by the operator are left
+	// unchanged and reported in the QueuesInSync condition.
+	// queues is optional.
+	Queues *QueuesApplyConfiguration `json:"queues,omitempty"`
+	// namespaceProtection restricts who may add, change or remove the namespace labels
+	// that opt namespaces in or out of Kueue management, and prevents their removal
+	// while the namespace has admitted workloads.
+	// It is enforced with a ValidatingAdmissionPolicy on namespace updates.
+	// namespaceProtection is optional.
+	NamespaceProtection *NamespaceProtectionApplyConfiguration `json:"namespaceProtection,omitempty"`
+	// containerDefaults makes the operator create a LimitRange setting the default
+	// requests and limits of containers in every namespace labeled with
+	// kueue.openshift.io/managed=true, so that Kueue accounts for the pods created
+	// without requests.
+	// No LimitRange is created in the namespaces where a LimitRange not created by the
+	// operator sets defaults for the same resources, or bounds them so that the
+	// defaults would be rejected. These namespaces are reported in the
+	// LimitRangesReady condition.
+	// The LimitRange is deleted when the namespace is no longer labeled.
+	// containerDefaults is optional.
+	ContainerDefaults *ContainerDefaultsApplyConfiguration `json:"containerDefaults,omitempty"`
+	// uninstallPolicy controls what happens to the workloads managed by Kueue when
+	// this Kueue CR is deleted.
+	// The allowed values are Orphan, ReleaseAll and Block.
+	// Orphan removes Kueue and leaves the workloads as they are: pods gated by Kueue
+	// and jobs suspended by Kueue stay pending until Kueue is installed again.
+	// ReleaseAll stops Kueue, then removes the kueue.x-k8s.io/admission scheduling gate
+	// from pods and unsuspends the jobs of the enabled integrations that were not
+	// admitted, before the CR is removed. Released workloads start without quota.
+	// Block keeps the CR, and Kueue, until no workload is waiting for admission. The
+	// pending workloads are reported in the UninstallReady condition.
+	// When set to "", this means no opinion and the operator will choose a reasonable default.
+	// The current default is Orphan.
+	UninstallPolicy *kueueoperatorv1.UninstallPolicy `json:"uninstallPolicy,omitempty"`
+	// removalPolicy controls whether the Kueue CRDs, and the Kueue custom resources such
+	// as ClusterQueues, LocalQueues and Workloads, are kept when this Kueue CR is deleted.
+	// The allowed values are Retain and Delete.
+	// Retain keeps them, so that Kueue can be installed again with its queues.
+	// Delete deletes the Kueue custom resources, removing their finalizers, and then the
+	// Kueue CRDs. Use uninstallExport to keep a copy of them.
+	// When set to "", this means no opinion and the operator will choose a reasonable default.
+	// The current default is Retain.
+	RemovalPolicy *kueueoperatorv1.RemovalPolicy `json:"removalPolicy,omitempty"`
+	// uninstallExport exports the Kueue custom resources to ConfigMaps when this Kueue CR
+	// is deleted, before anything is deleted. Exporting to a PersistentVolumeClaim is
+	// not supported.
+	// When omitted, no export is taken.
+	UninstallExport *UninstallExportApplyConfiguration `json:"uninstallExport,omitempty"`
+	// monitoring configures the PrometheusRule the operator creates for Kueue when the
+	// PrometheusRule CRD is installed. It holds alerts on the availability of Kueue,
+	// the workloads which stay inadmissible, the latency of the admission cycles, the
+	// errors of the webhooks, the expiry of the certificates and the inactive
+	// ClusterQueues, and recording rules of the utilization of the ClusterQueues.
+	// When omitted, all the rules are created with their default thresholds.
+	Monitoring *MonitoringApplyConfiguration `json:"monitoring,omitempty"`
 }
 
 // KueueOperandSpecApplyConfiguration constructs a declarative configuration of the KueueOperandSpec type for use with
@@ -85,3 +147,59 @@ func (b *KueueOperandSpecApplyConfiguration) WithConfig(value *KueueConfiguratio
 	b.Config = value
 	return b
 }
+
+// WithQueues sets the Queues field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Queues field is set to the value of the last call.
+func (b *KueueOperandSpecApplyConfiguration) WithQueues(value *QueuesApplyConfiguration) *KueueOperandSpecApplyConfiguration {
+	b.Queues = value
+	return b
+}
+
+// WithNamespaceProtection sets the NamespaceProtection field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NamespaceProtection field is set to the value of the last call.
+func (b *KueueOperandSpecApplyConfiguration) WithNamespaceProtection(value *NamespaceProtectionApplyConfiguration) *KueueOperandSpecApplyConfiguration {
+	b.NamespaceProtection = value
+	return b
+}
+
+// WithContainerDefaults sets the ContainerDefaults field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ContainerDefaults field is set to the value of the last call.
+func (b *KueueOperandSpecApplyConfiguration) WithContainerDefaults(value *ContainerDefaultsApplyConfiguration) *KueueOperandSpecApplyConfiguration {
+	b.ContainerDefaults = value
+	return b
+}
+
+// WithUninstallPolicy sets the UninstallPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UninstallPolicy field is set to the value of the last call.
+func (b *KueueOperandSpecApplyConfiguration) WithUninstallPolicy(value kueueoperatorv1.UninstallPolicy) *KueueOperandSpecApplyConfiguration {
+	b.UninstallPolicy = &value
+	return b
+}
+
+// WithRemovalPolicy sets the RemovalPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RemovalPolicy field is set to the value of the last call.
+func (b *KueueOperandSpecApplyConfiguration) WithRemovalPolicy(value kueueoperatorv1.RemovalPolicy) *KueueOperandSpecApplyConfiguration {
+	b.RemovalPolicy = &value
+	return b
+}
+
+// WithUninstallExport sets the UninstallExport field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UninstallExport field is set to the value of the last call.
+func (b *KueueOperandSpecApplyConfiguration) WithUninstallExport(value *UninstallExportApplyConfiguration) *KueueOperandSpecApplyConfiguration {
+	b.UninstallExport = value
+	return b
+}
+
+// WithMonitoring sets the Monitoring field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Monitoring field is set to the value of the last call.
+func (b *KueueOperandSpecApplyConfiguration) WithMonitoring(value *MonitoringApplyConfiguration) *KueueOperandSpecApplyConfiguration {
+	b.Monitoring = value
+	return b
+}
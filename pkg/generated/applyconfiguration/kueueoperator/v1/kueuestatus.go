@@ -27,6 +27,36 @@ import (
 // KueueStatus defines the observed state of Kueue
 type KueueStatusApplyConfiguration struct {
 	operatorv1.OperatorStatusApplyConfiguration `json:",inline"`
+	// integrations reports whether the APIs required by each enabled
+	// integration are installed on the cluster.
+	// integrations, if specified, can not have more than 18 items.
+	Integrations []IntegrationStatusApplyConfiguration `json:"integrations,omitempty"`
+	// discoveredDeviceClassMappings are the DeviceClass mappings derived from
+	// the DeviceClasses installed on the cluster when
+	// spec.config.resources.autoDiscover.mode is Propose or Generate.
+	// discoveredDeviceClassMappings, if specified, can not have more than 16 items.
+	DiscoveredDeviceClassMappings []DeviceClassMappingApplyConfiguration `json:"discoveredDeviceClassMappings,omitempty"`
+	// labelPolicyPreview reports the objects that would become managed by Kueue when
+	// labelPolicy changes from QueueName to None.
+	// It is reported while the change is pending, or when previewed by annotating the
+	// Kueue CR with kueue.openshift.io/preview-label-policy=None.
+	LabelPolicyPreview *LabelPolicyPreviewApplyConfiguration `json:"labelPolicyPreview,omitempty"`
+	// effectiveConfiguration is the configuration Kueue is deployed with, where the
+	// values left to the operator are resolved.
+	EffectiveConfiguration *EffectiveConfigurationApplyConfiguration `json:"effectiveConfiguration,omitempty"`
+	// kueueImage is the image of the Kueue deployment.
+	// kueueImage, if specified, can not be longer than 512 characters.
+	KueueImage *string `json:"kueueImage,omitempty"`
+	// kueueVersion is the version of the Kueue Go module the operator is built with,
+	// falling back to the Kueue release its API versions apply to. It is not read from
+	// kueueImage, which is configured separately and may hold another release.
+	// kueueVersion, if specified, can not be longer than 64 characters.
+	KueueVersion *string `json:"kueueVersion,omitempty"`
+	// relatedObjects are the objects the operator manages: the namespace of Kueue,
+	// which holds its namespaced objects, and the cluster-scoped objects and the
+	// objects of the other namespaces.
+	// relatedObjects, if specified, can not have more than 512 items.
+	RelatedObjects []RelatedObjectApplyConfiguration `json:"relatedObjects,omitempty"`
 }
 
 // KueueStatusApplyConfiguration constructs a declarative configuration of the KueueStatus type for use with
@@ -92,3 +122,74 @@ func (b *KueueStatusApplyConfiguration) WithGenerations(values ...*operatorv1.Ge
 	}
 	return b
 }
+
+// WithIntegrations adds the given value to the Integrations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Integrations field.
+func (b *KueueStatusApplyConfiguration) WithIntegrations(values ...*IntegrationStatusApplyConfiguration) *KueueStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithIntegrations")
+		}
+		b.Integrations = append(b.Integrations, *values[i])
+	}
+	return b
+}
+
+// WithDiscoveredDeviceClassMappings adds the given value to the DiscoveredDeviceClassMappings field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DiscoveredDeviceClassMappings field.
+func (b *KueueStatusApplyConfiguration) WithDiscoveredDeviceClassMappings(values ...*DeviceClassMappingApplyConfiguration) *KueueStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithDiscoveredDeviceClassMappings")
+		}
+		b.DiscoveredDeviceClassMappings = append(b.DiscoveredDeviceClassMappings, *values[i])
+	}
+	return b
+}
+
+// WithLabelPolicyPreview sets the LabelPolicyPreview field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LabelPolicyPreview field is set to the value of the last call.
+func (b *KueueStatusApplyConfiguration) WithLabelPolicyPreview(value *LabelPolicyPreviewApplyConfiguration) *KueueStatusApplyConfiguration {
+	b.LabelPolicyPreview = value
+	return b
+}
+
+// WithEffectiveConfiguration sets the EffectiveConfiguration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EffectiveConfiguration field is set to the value of the last call.
+func (b *KueueStatusApplyConfiguration) WithEffectiveConfiguration(value *EffectiveConfigurationApplyConfiguration) *KueueStatusApplyConfiguration {
+	b.EffectiveConfiguration = value
+	return b
+}
+
+// WithKueueImage sets the KueueImage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the KueueImage field is set to the value of the last call.
+func (b *KueueStatusApplyConfiguration) WithKueueImage(value string) *KueueStatusApplyConfiguration {
+	b.KueueImage = &value
+	return b
+}
+
+// WithKueueVersion sets the KueueVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the KueueVersion field is set to the value of the last call.
+func (b *KueueStatusApplyConfiguration) WithKueueVersion(value string) *KueueStatusApplyConfiguration {
+	b.KueueVersion = &value
+	return b
+}
+
+// WithRelatedObjects adds the given value to the RelatedObjects field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the RelatedObjects field.
+func (b *KueueStatusApplyConfiguration) WithRelatedObjects(values ...*RelatedObjectApplyConfiguration) *KueueStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithRelatedObjects")
+		}
+		b.RelatedObjects = append(b.RelatedObjects, *values[i])
+	}
+	return b
+}
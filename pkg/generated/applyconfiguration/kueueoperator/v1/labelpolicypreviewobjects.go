@@ -0,0 +1,66 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	kueueoperatorv1 "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+)
+
+// LabelPolicyPreviewObjectsApplyConfiguration represents a declarative configuration of the LabelPolicyPreviewObjects type for use
+// with apply.
+//
+// LabelPolicyPreviewObjects is the number of objects of an integration in a namespace
+// that would become managed by Kueue.
+type LabelPolicyPreviewObjectsApplyConfiguration struct {
+	// namespace is the namespace of the objects.
+	Namespace *string `json:"namespace,omitempty"`
+	// integration is the integration of the objects.
+	Integration *kueueoperatorv1.KueueIntegration `json:"integration,omitempty"`
+	// count is the number of objects.
+	Count *int32 `json:"count,omitempty"`
+}
+
+// LabelPolicyPreviewObjectsApplyConfiguration constructs a declarative configuration of the LabelPolicyPreviewObjects type for use with
+// apply.
+func LabelPolicyPreviewObjects() *LabelPolicyPreviewObjectsApplyConfiguration {
+	return &LabelPolicyPreviewObjectsApplyConfiguration{}
+}
+
+// WithNamespace sets the Namespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Namespace field is set to the value of the last call.
+func (b *LabelPolicyPreviewObjectsApplyConfiguration) WithNamespace(value string) *LabelPolicyPreviewObjectsApplyConfiguration {
+	b.Namespace = &value
+	return b
+}
+
+// WithIntegration sets the Integration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Integration field is set to the value of the last call.
+func (b *LabelPolicyPreviewObjectsApplyConfiguration) WithIntegration(value kueueoperatorv1.KueueIntegration) *LabelPolicyPreviewObjectsApplyConfiguration {
+	b.Integration = &value
+	return b
+}
+
+// WithCount sets the Count field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Count field is set to the value of the last call.
+func (b *LabelPolicyPreviewObjectsApplyConfiguration) WithCount(value int32) *LabelPolicyPreviewObjectsApplyConfiguration {
+	b.Count = &value
+	return b
+}
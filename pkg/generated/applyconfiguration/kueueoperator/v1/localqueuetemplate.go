@@ -0,0 +1,53 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// LocalQueueTemplateApplyConfiguration represents a declarative configuration of the LocalQueueTemplate type for use
+// with apply.
+//
+// LocalQueueTemplate describes a LocalQueue created by the operator in every managed namespace.
+type LocalQueueTemplateApplyConfiguration struct {
+	// name is the name of the LocalQueue.
+	// Must be a valid DNS 1123 subdomain consisting of lower-case alphanumeric characters,
+	// hyphens and periods, of at most 253 characters in length.
+	Name *string `json:"name,omitempty"`
+	// clusterQueue is the name of the ClusterQueue the LocalQueue points to.
+	ClusterQueue *string `json:"clusterQueue,omitempty"`
+}
+
+// LocalQueueTemplateApplyConfiguration constructs a declarative configuration of the LocalQueueTemplate type for use with
+// apply.
+func LocalQueueTemplate() *LocalQueueTemplateApplyConfiguration {
+	return &LocalQueueTemplateApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *LocalQueueTemplateApplyConfiguration) WithName(value string) *LocalQueueTemplateApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithClusterQueue sets the ClusterQueue field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClusterQueue field is set to the value of the last call.
+func (b *LocalQueueTemplateApplyConfiguration) WithClusterQueue(value string) *LocalQueueTemplateApplyConfiguration {
+	b.ClusterQueue = &value
+	return b
+}
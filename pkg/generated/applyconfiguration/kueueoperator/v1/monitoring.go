@@ -0,0 +1,66 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	kueueoperatorv1 "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+)
+
+// MonitoringApplyConfiguration represents a declarative configuration of the Monitoring type for use
+// with apply.
+//
+// Monitoring configures the alerting and recording rules of Kueue.
+type MonitoringApplyConfiguration struct {
+	// alerts adjusts or disables the alerts of the PrometheusRule.
+	// The alerts that are not listed are created with their default threshold.
+	// alerts, if specified, must have at least one item and no more than 6 items.
+	Alerts []AlertConfigurationApplyConfiguration `json:"alerts,omitempty"`
+	// recordingRules controls the recording rules of the utilization of the quotas of
+	// the ClusterQueues and of their pending workloads.
+	// The allowed values are Enabled, Disabled and "".
+	// When set to "", this means no opinion and the operator will choose a reasonable default.
+	// The current default is Enabled.
+	RecordingRules *kueueoperatorv1.RuleState `json:"recordingRules,omitempty"`
+}
+
+// MonitoringApplyConfiguration constructs a declarative configuration of the Monitoring type for use with
+// apply.
+func Monitoring() *MonitoringApplyConfiguration {
+	return &MonitoringApplyConfiguration{}
+}
+
+// WithAlerts adds the given value to the Alerts field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Alerts field.
+func (b *MonitoringApplyConfiguration) WithAlerts(values ...*AlertConfigurationApplyConfiguration) *MonitoringApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAlerts")
+		}
+		b.Alerts = append(b.Alerts, *values[i])
+	}
+	return b
+}
+
+// WithRecordingRules sets the RecordingRules field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RecordingRules field is set to the value of the last call.
+func (b *MonitoringApplyConfiguration) WithRecordingRules(value kueueoperatorv1.RuleState) *MonitoringApplyConfiguration {
+	b.RecordingRules = &value
+	return b
+}
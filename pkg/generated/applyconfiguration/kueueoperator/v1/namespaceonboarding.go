@@ -0,0 +1,61 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// NamespaceOnboardingApplyConfiguration represents a declarative configuration of the NamespaceOnboarding type for use
+// with apply.
+//
+// NamespaceOnboarding selects the ClusterQueue of the default LocalQueue of each
+// managed namespace.
+type NamespaceOnboardingApplyConfiguration struct {
+	// clusterQueueMappings select the ClusterQueue by namespace labels.
+	// The first mapping whose namespaceLabels are all set on the namespace is used.
+	// clusterQueueMappings, if specified, can not have more than 16 items.
+	ClusterQueueMappings []ClusterQueueMappingApplyConfiguration `json:"clusterQueueMappings,omitempty"`
+	// defaultClusterQueue is the ClusterQueue used when neither the namespace
+	// annotation nor clusterQueueMappings select one.
+	// When omitted, such namespaces get no default LocalQueue.
+	DefaultClusterQueue *string `json:"defaultClusterQueue,omitempty"`
+}
+
+// NamespaceOnboardingApplyConfiguration constructs a declarative configuration of the NamespaceOnboarding type for use with
+// apply.
+func NamespaceOnboarding() *NamespaceOnboardingApplyConfiguration {
+	return &NamespaceOnboardingApplyConfiguration{}
+}
+
+// WithClusterQueueMappings adds the given value to the ClusterQueueMappings field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ClusterQueueMappings field.
+func (b *NamespaceOnboardingApplyConfiguration) WithClusterQueueMappings(values ...*ClusterQueueMappingApplyConfiguration) *NamespaceOnboardingApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithClusterQueueMappings")
+		}
+		b.ClusterQueueMappings = append(b.ClusterQueueMappings, *values[i])
+	}
+	return b
+}
+
+// WithDefaultClusterQueue sets the DefaultClusterQueue field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DefaultClusterQueue field is set to the value of the last call.
+func (b *NamespaceOnboardingApplyConfiguration) WithDefaultClusterQueue(value string) *NamespaceOnboardingApplyConfiguration {
+	b.DefaultClusterQueue = &value
+	return b
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// QueueResourceGroupApplyConfiguration represents a declarative configuration of the QueueResourceGroup type for use
+// with apply.
+//
+// QueueResourceGroup describes the quotas of a group of resources, for each flavor.
+type QueueResourceGroupApplyConfiguration struct {
+	// coveredResources are the resources covered by the flavors of this group,
+	// e.g. cpu, memory or nvidia.com/gpu.
+	// coveredResources must have at least one item and no more than 16 items.
+	CoveredResources []string `json:"coveredResources,omitempty"`
+	// flavors are the flavors providing the covered resources, in the order they are tried.
+	// flavors must have at least one item and no more than 16 items.
+	Flavors []FlavorQuotasApplyConfiguration `json:"flavors,omitempty"`
+}
+
+// QueueResourceGroupApplyConfiguration constructs a declarative configuration of the QueueResourceGroup type for use with
+// apply.
+func QueueResourceGroup() *QueueResourceGroupApplyConfiguration {
+	return &QueueResourceGroupApplyConfiguration{}
+}
+
+// WithCoveredResources adds the given value to the CoveredResources field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the CoveredResources field.
+func (b *QueueResourceGroupApplyConfiguration) WithCoveredResources(values ...string) *QueueResourceGroupApplyConfiguration {
+	for i := range values {
+		b.CoveredResources = append(b.CoveredResources, values[i])
+	}
+	return b
+}
+
+// WithFlavors adds the given value to the Flavors field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Flavors field.
+func (b *QueueResourceGroupApplyConfiguration) WithFlavors(values ...*FlavorQuotasApplyConfiguration) *QueueResourceGroupApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithFlavors")
+		}
+		b.Flavors = append(b.Flavors, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	kueueoperatorv1 "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+)
+
+// RBACApplyConfiguration represents a declarative configuration of the RBAC type for use
+// with apply.
+//
+// RBAC configures the ClusterRoles installed for Kueue.
+type RBACApplyConfiguration struct {
+	// defaultRoleAggregation controls whether the namespaced Kueue ClusterRoles
+	// are aggregated into the OpenShift admin, edit and view ClusterRoles.
+	// The allowed values are Enabled, Disabled and "".
+	// When set to Enabled, the editor roles of the enabled integrations are aggregated
+	// into edit and admin, their viewer roles and the LocalQueue, Workload and
+	// pending workloads viewer roles are aggregated into view, and the LocalQueue
+	// editor role is aggregated into admin.
+	// When set to Disabled, the roles are only aggregated into the Kueue
+	// batch-user and batch-admin ClusterRoles.
+	// When set to "", this means no opinion and the operator is left
+	// to choose a reasonable default, which is subject to change over time.
+	// The current default is Disabled.
+	DefaultRoleAggregation *kueueoperatorv1.DefaultRoleAggregation `json:"defaultRoleAggregation,omitempty"`
+}
+
+// RBACApplyConfiguration constructs a declarative configuration of the RBAC type for use with
+// apply.
+func RBAC() *RBACApplyConfiguration {
+	return &RBACApplyConfiguration{}
+}
+
+// WithDefaultRoleAggregation sets the DefaultRoleAggregation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DefaultRoleAggregation field is set to the value of the last call.
+func (b *RBACApplyConfiguration) WithDefaultRoleAggregation(value kueueoperatorv1.DefaultRoleAggregation) *RBACApplyConfiguration {
+	b.DefaultRoleAggregation = &value
+	return b
+}
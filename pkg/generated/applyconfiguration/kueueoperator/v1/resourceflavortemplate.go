@@ -0,0 +1,60 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// ResourceFlavorTemplateApplyConfiguration represents a declarative configuration of the ResourceFlavorTemplate type for use
+// with apply.
+//
+// ResourceFlavorTemplate describes a ResourceFlavor created by the operator.
+type ResourceFlavorTemplateApplyConfiguration struct {
+	// name is the name of the ResourceFlavor.
+	// Must be a valid DNS 1123 subdomain consisting of lower-case alphanumeric characters,
+	// hyphens and periods, of at most 253 characters in length.
+	Name *string `json:"name,omitempty"`
+	// nodeLabels are the labels of the nodes that provide this flavor.
+	// nodeLabels, if specified, can not have more than 8 entries.
+	NodeLabels map[string]string `json:"nodeLabels,omitempty"`
+}
+
+// ResourceFlavorTemplateApplyConfiguration constructs a declarative configuration of the ResourceFlavorTemplate type for use with
+// apply.
+func ResourceFlavorTemplate() *ResourceFlavorTemplateApplyConfiguration {
+	return &ResourceFlavorTemplateApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *ResourceFlavorTemplateApplyConfiguration) WithName(value string) *ResourceFlavorTemplateApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithNodeLabels puts the entries into the NodeLabels field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the NodeLabels field,
+// overwriting an existing map entries in NodeLabels field with the same key.
+func (b *ResourceFlavorTemplateApplyConfiguration) WithNodeLabels(entries map[string]string) *ResourceFlavorTemplateApplyConfiguration {
+	if b.NodeLabels == nil && len(entries) > 0 {
+		b.NodeLabels = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.NodeLabels[k] = v
+	}
+	return b
+}
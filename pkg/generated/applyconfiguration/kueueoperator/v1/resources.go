@@ -27,6 +27,14 @@ type ResourcesApplyConfiguration struct {
 	// Each DeviceClass name can only appear in one mapping.
 	// deviceClassMappings is limited to a maximum of 16 items.
 	DeviceClassMappings []DeviceClassMappingApplyConfiguration `json:"deviceClassMappings,omitempty"`
+	// autoDiscover configures how the operator derives deviceClassMappings
+	// from the DeviceClasses installed on the cluster.
+	// A DeviceClass is mapped to the Kueue resource name set in its
+	// kueue.openshift.io/resource-name label, or else to the name of the DRA
+	// driver selected by the DeviceClass.
+	// DeviceClasses already listed in deviceClassMappings are not discovered.
+	// autoDiscover is optional.
+	AutoDiscover *DeviceClassAutoDiscoverApplyConfiguration `json:"autoDiscover,omitempty"`
 }
 
 // ResourcesApplyConfiguration constructs a declarative configuration of the Resources type for use with
@@ -47,3 +55,11 @@ func (b *ResourcesApplyConfiguration) WithDeviceClassMappings(values ...*DeviceC
 	}
 	return b
 }
+
+// WithAutoDiscover sets the AutoDiscover field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AutoDiscover field is set to the value of the last call.
+func (b *ResourcesApplyConfiguration) WithAutoDiscover(value *DeviceClassAutoDiscoverApplyConfiguration) *ResourcesApplyConfiguration {
+	b.AutoDiscover = value
+	return b
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// UninstallExportApplyConfiguration represents a declarative configuration of the UninstallExport type for use
+// with apply.
+//
+// UninstallExport describes where the Kueue custom resources are exported when Kueue is
+// uninstalled. The export is only written to ConfigMaps, exporting to a
+// PersistentVolumeClaim is not supported.
+type UninstallExportApplyConfiguration struct {
+	// configMap exports the Kueue custom resources to ConfigMaps.
+	ConfigMap *ConfigMapExportApplyConfiguration `json:"configMap,omitempty"`
+}
+
+// UninstallExportApplyConfiguration constructs a declarative configuration of the UninstallExport type for use with
+// apply.
+func UninstallExport() *UninstallExportApplyConfiguration {
+	return &UninstallExportApplyConfiguration{}
+}
+
+// WithConfigMap sets the ConfigMap field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConfigMap field is set to the value of the last call.
+func (b *UninstallExportApplyConfiguration) WithConfigMap(value *ConfigMapExportApplyConfiguration) *UninstallExportApplyConfiguration {
+	b.ConfigMap = value
+	return b
+}
@@ -39,6 +39,20 @@ type WorkloadManagementApplyConfiguration struct {
 	// to choose a reasonable default, which is subject to change over time.
 	// The current default is QueueName.
 	LabelPolicy *kueueoperatorv1.LabelPolicy `json:"labelPolicy,omitempty"`
+	// labelPolicySwitch controls how a change of labelPolicy from QueueName to None is
+	// applied. With None, Kueue suspends every job of the managed namespaces that does
+	// not set the label kueue.x-k8s.io/queue-name, so the objects that would become
+	// managed are previewed in status.labelPolicyPreview.
+	// The allowed values are Immediate, RequireAcknowledgement and "".
+	// Immediate applies the change right away.
+	// RequireAcknowledgement keeps Kueue running with QueueName until the Kueue CR is
+	// annotated with kueue.openshift.io/acknowledge-label-policy=None.
+	// The annotation should be removed once the change is applied, so that a later
+	// change requires a new acknowledgement.
+	// When set to "", this means no opinion and the operator is left
+	// to choose a reasonable default, which is subject to change over time.
+	// The current default is Immediate.
+	LabelPolicySwitch *kueueoperatorv1.LabelPolicySwitch `json:"labelPolicySwitch,omitempty"`
 }
 
 // WorkloadManagementApplyConfiguration constructs a declarative configuration of the WorkloadManagement type for use with
@@ -54,3 +68,11 @@ func (b *WorkloadManagementApplyConfiguration) WithLabelPolicy(value kueueoperat
 	b.LabelPolicy = &value
 	return b
 }
+
+// WithLabelPolicySwitch sets the LabelPolicySwitch field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LabelPolicySwitch field is set to the value of the last call.
+func (b *WorkloadManagementApplyConfiguration) WithLabelPolicySwitch(value kueueoperatorv1.LabelPolicySwitch) *WorkloadManagementApplyConfiguration {
+	b.LabelPolicySwitch = &value
+	return b
+}
@@ -31,16 +31,38 @@ import (
 func ForKind(kind schema.GroupVersionKind) interface{} {
 	switch kind {
 	// Group=kueue.openshift.io, Version=v1
+	case v1.SchemeGroupVersion.WithKind("AlertConfiguration"):
+		return &kueueoperatorv1.AlertConfigurationApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ByWorkload"):
 		return &kueueoperatorv1.ByWorkloadApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ClusterQueueMapping"):
+		return &kueueoperatorv1.ClusterQueueMappingApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ClusterQueueTemplate"):
+		return &kueueoperatorv1.ClusterQueueTemplateApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("CohortTemplate"):
+		return &kueueoperatorv1.CohortTemplateApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ConfigMapExport"):
+		return &kueueoperatorv1.ConfigMapExportApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ContainerDefaults"):
+		return &kueueoperatorv1.ContainerDefaultsApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ContainerResourceDefault"):
+		return &kueueoperatorv1.ContainerResourceDefaultApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("DeviceClassAutoDiscover"):
+		return &kueueoperatorv1.DeviceClassAutoDiscoverApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("DeviceClassMapping"):
 		return &kueueoperatorv1.DeviceClassMappingApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("EffectiveConfiguration"):
+		return &kueueoperatorv1.EffectiveConfigurationApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ExternalFramework"):
 		return &kueueoperatorv1.ExternalFrameworkApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("FlavorQuotas"):
+		return &kueueoperatorv1.FlavorQuotasApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("GangScheduling"):
 		return &kueueoperatorv1.GangSchedulingApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Integrations"):
 		return &kueueoperatorv1.IntegrationsApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("IntegrationStatus"):
+		return &kueueoperatorv1.IntegrationStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Kueue"):
 		return &kueueoperatorv1.KueueApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("KueueConfiguration"):
@@ -51,12 +73,48 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &kueueoperatorv1.KueueStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("LabelKeys"):
 		return &kueueoperatorv1.LabelKeysApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("LabelPolicyPreview"):
+		return &kueueoperatorv1.LabelPolicyPreviewApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("LabelPolicyPreviewObjects"):
+		return &kueueoperatorv1.LabelPolicyPreviewObjectsApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("LocalQueueTemplate"):
+		return &kueueoperatorv1.LocalQueueTemplateApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("Metrics"):
+		return &kueueoperatorv1.MetricsApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("Monitoring"):
+		return &kueueoperatorv1.MonitoringApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("MultiKueue"):
 		return &kueueoperatorv1.MultiKueueApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("NamespaceOnboarding"):
+		return &kueueoperatorv1.NamespaceOnboardingApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("NamespaceProtection"):
+		return &kueueoperatorv1.NamespaceProtectionApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Preemption"):
 		return &kueueoperatorv1.PreemptionApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("QueueAssignmentPolicy"):
+		return &kueueoperatorv1.QueueAssignmentPolicyApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("QueueAssignmentRule"):
+		return &kueueoperatorv1.QueueAssignmentRuleApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("QueueResourceGroup"):
+		return &kueueoperatorv1.QueueResourceGroupApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("Queues"):
+		return &kueueoperatorv1.QueuesApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RBAC"):
+		return &kueueoperatorv1.RBACApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RelatedObject"):
+		return &kueueoperatorv1.RelatedObjectApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ResourceFlavorTemplate"):
+		return &kueueoperatorv1.ResourceFlavorTemplateApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ResourceQuota"):
+		return &kueueoperatorv1.ResourceQuotaApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Resources"):
 		return &kueueoperatorv1.ResourcesApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("TenantGuardrailRule"):
+		return &kueueoperatorv1.TenantGuardrailRuleApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("TenantGuardrails"):
+		return &kueueoperatorv1.TenantGuardrailsApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("UninstallExport"):
+		return &kueueoperatorv1.UninstallExportApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("WorkloadManagement"):
 		return &kueueoperatorv1.WorkloadManagementApplyConfiguration{}
 
@@ -0,0 +1,197 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package integration describes the APIs behind each Kueue integration
+// and checks whether they are installed on the cluster.
+package integration
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	kueue "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// KueueVersion is the Kueue release the API versions below apply to. It must be
+// updated together with them when the bundled Kueue is upgraded.
+const KueueVersion = "v0.16"
+
+// API describes the resource Kueue manages for an integration.
+type API struct {
+	Group    string
+	Version  string
+	Resource string
+	Kind     string
+	// BuiltIn is true for resources served by the Kubernetes API server itself,
+	// which do not require a CRD to be installed.
+	BuiltIn bool
+	// Provider is the earliest release of the project serving Version, reported to
+	// users when the installed CRD is not compatible.
+	Provider string
+	// SuspendPath is the path of the field Kueue suspends the resource with. It is
+	// empty for the integrations whose pods are gated instead.
+	SuspendPath []string
+}
+
+// GroupVersionResource returns the GVR of the integration API.
+func (a API) GroupVersionResource() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: a.Group, Version: a.Version, Resource: a.Resource}
+}
+
+// CRDName returns the name of the CustomResourceDefinition serving the API.
+func (a API) CRDName() string {
+	return a.Resource + "." + a.Group
+}
+
+// apis maps each integration to the API version the bundled Kueue release manages for
+// it. Kueue is built against a single version of each integration API, the CRD of an
+// integration is compatible when it serves this version.
+var apis = map[kueue.KueueIntegration]API{
+	kueue.KueueIntegrationBatchJob:         {Group: "batch", Version: "v1", Resource: "jobs", Kind: "Job", BuiltIn: true, SuspendPath: []string{"spec", "suspend"}},
+	kueue.KueueIntegrationPod:              {Group: "", Version: "v1", Resource: "pods", Kind: "Pod", BuiltIn: true},
+	kueue.KueueIntegrationDeployment:       {Group: "apps", Version: "v1", Resource: "deployments", Kind: "Deployment", BuiltIn: true},
+	kueue.KueueIntegrationStatefulSet:      {Group: "apps", Version: "v1", Resource: "statefulsets", Kind: "StatefulSet", BuiltIn: true},
+	kueue.KueueIntegrationRayJob:           {Group: "ray.io", Version: "v1", Resource: "rayjobs", Kind: "RayJob", Provider: "KubeRay v1.0", SuspendPath: []string{"spec", "suspend"}},
+	kueue.KueueIntegrationRayCluster:       {Group: "ray.io", Version: "v1", Resource: "rayclusters", Kind: "RayCluster", Provider: "KubeRay v1.0", SuspendPath: []string{"spec", "suspend"}},
+	kueue.KueueIntegrationRayService:       {Group: "ray.io", Version: "v1", Resource: "rayservices", Kind: "RayService", Provider: "KubeRay v1.0"},
+	kueue.KueueIntegrationJobSet:           {Group: "jobset.x-k8s.io", Version: "v1alpha2", Resource: "jobsets", Kind: "JobSet", Provider: "JobSet v0.2", SuspendPath: []string{"spec", "suspend"}},
+	kueue.KueueIntegrationMPIJob:           {Group: "kubeflow.org", Version: "v2beta1", Resource: "mpijobs", Kind: "MPIJob", Provider: "MPI Operator v0.4", SuspendPath: []string{"spec", "runPolicy", "suspend"}},
+	kueue.KueueIntegrationPaddleJob:        {Group: "kubeflow.org", Version: "v1", Resource: "paddlejobs", Kind: "PaddleJob", Provider: "Kubeflow Training Operator v1.7", SuspendPath: []string{"spec", "runPolicy", "suspend"}},
+	kueue.KueueIntegrationPyTorchJob:       {Group: "kubeflow.org", Version: "v1", Resource: "pytorchjobs", Kind: "PyTorchJob", Provider: "Kubeflow Training Operator v1.7", SuspendPath: []string{"spec", "runPolicy", "suspend"}},
+	kueue.KueueIntegrationTFJob:            {Group: "kubeflow.org", Version: "v1", Resource: "tfjobs", Kind: "TFJob", Provider: "Kubeflow Training Operator v1.7", SuspendPath: []string{"spec", "runPolicy", "suspend"}},
+	kueue.KueueIntegrationXGBoostJob:       {Group: "kubeflow.org", Version: "v1", Resource: "xgboostjobs", Kind: "XGBoostJob", Provider: "Kubeflow Training Operator v1.7", SuspendPath: []string{"spec", "runPolicy", "suspend"}},
+	kueue.KueueIntegrationJaxJob:           {Group: "kubeflow.org", Version: "v1", Resource: "jaxjobs", Kind: "JAXJob", Provider: "Kubeflow Training Operator v1.9", SuspendPath: []string{"spec", "runPolicy", "suspend"}},
+	kueue.KueueIntegrationTrainJob:         {Group: "trainer.kubeflow.org", Version: "v1alpha1", Resource: "trainjobs", Kind: "TrainJob", Provider: "Kubeflow Trainer v2.0", SuspendPath: []string{"spec", "suspend"}},
+	kueue.KueueIntegrationAppWrapper:       {Group: "workload.codeflare.dev", Version: "v1beta2", Resource: "appwrappers", Kind: "AppWrapper", Provider: "AppWrapper v1.0", SuspendPath: []string{"spec", "suspend"}},
+	kueue.KueueIntegrationLeaderWorkerSet:  {Group: "leaderworkerset.x-k8s.io", Version: "v1", Resource: "leaderworkersets", Kind: "LeaderWorkerSet", Provider: "LeaderWorkerSet v0.3"},
+	kueue.KueueIntegrationSparkApplication: {Group: "sparkoperator.k8s.io", Version: "v1beta2", Resource: "sparkapplications", Kind: "SparkApplication", Provider: "Spark Operator v2.0", SuspendPath: []string{"spec", "suspend"}},
+}
+
+// APIFor returns the API Kueue uses for the given integration.
+func APIFor(framework kueue.KueueIntegration) (API, bool) {
+	api, ok := apis[framework]
+	return api, ok
+}
+
+// CheckCRD checks that the CRD installed for an integration serves the API version
+// Kueue manages.
+// crd is nil when the CRD is not installed.
+func CheckCRD(framework kueue.KueueIntegration, crd *apiextensionsv1.CustomResourceDefinition) kueue.IntegrationStatus {
+	status := kueue.IntegrationStatus{Name: framework}
+
+	api, ok := APIFor(framework)
+	if !ok {
+		status.State = kueue.IntegrationStateMissing
+		status.Message = fmt.Sprintf("%s is not a known integration", framework)
+		return status
+	}
+	status.RequiredVersion = api.Version
+	if api.BuiltIn {
+		status.State = kueue.IntegrationStateAvailable
+		status.Message = fmt.Sprintf("%s is served by the Kubernetes API server", api.GroupVersionResource().GroupResource())
+		return status
+	}
+	if crd == nil {
+		status.State = kueue.IntegrationStateMissing
+		status.Message = fmt.Sprintf("CustomResourceDefinition %s is not installed, %s requires %s or later", api.CRDName(), framework, api.Provider)
+		return status
+	}
+
+	status.ServedVersions = ServedVersions(crd)
+	if !slices.Contains(status.ServedVersions, api.Version) {
+		status.State = kueue.IntegrationStateVersionMismatch
+		status.Message = fmt.Sprintf("CustomResourceDefinition %s serves versions [%s], Kueue %s requires %s/%s, served by %s or later",
+			api.CRDName(), strings.Join(status.ServedVersions, ", "), KueueVersion, api.Group, api.Version, api.Provider)
+		return status
+	}
+
+	status.State = kueue.IntegrationStateAvailable
+	status.Message = fmt.Sprintf("CustomResourceDefinition %s serves version %s", api.CRDName(), api.Version)
+	return status
+}
+
+// ServedVersions returns the versions served by the CRD.
+func ServedVersions(crd *apiextensionsv1.CustomResourceDefinition) []string {
+	served := []string{}
+	for _, version := range crd.Spec.Versions {
+		if version.Served {
+			served = append(served, version.Name)
+		}
+	}
+	return served
+}
+
+// Unavailable returns the integrations whose API is not available, in the order of statuses.
+func Unavailable(statuses []kueue.IntegrationStatus) []kueue.KueueIntegration {
+	unavailable := []kueue.KueueIntegration{}
+	for _, status := range statuses {
+		if status.State != kueue.IntegrationStateAvailable {
+			unavailable = append(unavailable, status.Name)
+		}
+	}
+	return unavailable
+}
+
+// Skipped returns the integrations that are left out of the Kueue configuration
+// because their API is not available.
+// All unavailable integrations are skipped when the missing dependency policy is Skip.
+// Otherwise only the incompatible integrations listed in skipIncompatibleFrameworks are.
+func Skipped(integrations kueue.Integrations, statuses []kueue.IntegrationStatus) []kueue.KueueIntegration {
+	skipped := []kueue.KueueIntegration{}
+	for _, status := range statuses {
+		switch {
+		case status.State == kueue.IntegrationStateAvailable:
+		case integrations.MissingDependencyPolicy == kueue.MissingDependencyPolicySkip:
+			skipped = append(skipped, status.Name)
+		case status.State == kueue.IntegrationStateVersionMismatch && slices.Contains(integrations.SkipIncompatibleFrameworks, status.Name):
+			skipped = append(skipped, status.Name)
+		}
+	}
+	return skipped
+}
+
+// WithoutFrameworks returns a copy of the configuration without the given frameworks.
+func WithoutFrameworks(cfg kueue.KueueConfiguration, skipped []kueue.KueueIntegration) kueue.KueueConfiguration {
+	if len(skipped) == 0 {
+		return cfg
+	}
+	frameworks := make([]kueue.KueueIntegration, 0, len(cfg.Integrations.Frameworks))
+	for _, framework := range cfg.Integrations.Frameworks {
+		if !slices.Contains(skipped, framework) {
+			frameworks = append(frameworks, framework)
+		}
+	}
+	cfg.Integrations.Frameworks = frameworks
+	return cfg
+}
+
+// Enabled returns the integrations enabled in Kueue by the frameworks of the
+// configuration. The Pod integration is implicitly enabled by the LeaderWorkerSet,
+// StatefulSet and Deployment integrations, whose pods are gated through it.
+func Enabled(frameworks []kueue.KueueIntegration) []kueue.KueueIntegration {
+	enabled := slices.Clone(frameworks)
+	if slices.ContainsFunc(frameworks, func(framework kueue.KueueIntegration) bool {
+		return framework == kueue.KueueIntegrationLeaderWorkerSet ||
+			framework == kueue.KueueIntegrationStatefulSet ||
+			framework == kueue.KueueIntegrationDeployment
+	}) && !slices.Contains(frameworks, kueue.KueueIntegrationPod) {
+		enabled = append(enabled, kueue.KueueIntegrationPod)
+	}
+	return enabled
+}
@@ -0,0 +1,231 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kueue "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+)
+
+func crdWithVersions(name string, versions map[string]bool) *apiextensionsv1.CustomResourceDefinition {
+	crd := &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+	}
+	for _, version := range []string{"v1alpha1", "v1beta1", "v1", "v2beta1"} {
+		if served, ok := versions[version]; ok {
+			crd.Spec.Versions = append(crd.Spec.Versions, apiextensionsv1.CustomResourceDefinitionVersion{Name: version, Served: served})
+		}
+	}
+	return crd
+}
+
+func TestAllIntegrationsHaveAPIs(t *testing.T) {
+	for _, framework := range []kueue.KueueIntegration{
+		kueue.KueueIntegrationBatchJob,
+		kueue.KueueIntegrationRayJob,
+		kueue.KueueIntegrationRayCluster,
+		kueue.KueueIntegrationRayService,
+		kueue.KueueIntegrationJobSet,
+		kueue.KueueIntegrationMPIJob,
+		kueue.KueueIntegrationPaddleJob,
+		kueue.KueueIntegrationPyTorchJob,
+		kueue.KueueIntegrationTFJob,
+		kueue.KueueIntegrationTrainJob,
+		kueue.KueueIntegrationXGBoostJob,
+		kueue.KueueIntegrationJaxJob,
+		kueue.KueueIntegrationAppWrapper,
+		kueue.KueueIntegrationPod,
+		kueue.KueueIntegrationDeployment,
+		kueue.KueueIntegrationStatefulSet,
+		kueue.KueueIntegrationLeaderWorkerSet,
+		kueue.KueueIntegrationSparkApplication,
+	} {
+		if _, ok := APIFor(framework); !ok {
+			t.Errorf("no API registered for integration %s", framework)
+		}
+	}
+}
+
+func TestCheckCRD(t *testing.T) {
+	testCases := map[string]struct {
+		framework kueue.KueueIntegration
+		crd       *apiextensionsv1.CustomResourceDefinition
+		want      kueue.IntegrationStatus
+	}{
+		"built-in integration does not need a CRD": {
+			framework: kueue.KueueIntegrationBatchJob,
+			want: kueue.IntegrationStatus{
+				Name:            kueue.KueueIntegrationBatchJob,
+				State:           kueue.IntegrationStateAvailable,
+				RequiredVersion: "v1",
+			},
+		},
+		"missing CRD": {
+			framework: kueue.KueueIntegrationRayJob,
+			want: kueue.IntegrationStatus{
+				Name:            kueue.KueueIntegrationRayJob,
+				State:           kueue.IntegrationStateMissing,
+				RequiredVersion: "v1",
+			},
+		},
+		"CRD serves the supported version": {
+			framework: kueue.KueueIntegrationRayJob,
+			crd:       crdWithVersions("rayjobs.ray.io", map[string]bool{"v1alpha1": true, "v1": true}),
+			want: kueue.IntegrationStatus{
+				Name:            kueue.KueueIntegrationRayJob,
+				State:           kueue.IntegrationStateAvailable,
+				ServedVersions:  []string{"v1alpha1", "v1"},
+				RequiredVersion: "v1",
+			},
+		},
+		"CRD only serves an older version": {
+			framework: kueue.KueueIntegrationRayCluster,
+			crd:       crdWithVersions("rayclusters.ray.io", map[string]bool{"v1alpha1": true}),
+			want: kueue.IntegrationStatus{
+				Name:            kueue.KueueIntegrationRayCluster,
+				State:           kueue.IntegrationStateVersionMismatch,
+				ServedVersions:  []string{"v1alpha1"},
+				RequiredVersion: "v1",
+			},
+		},
+		"CRD does not serve the supported version": {
+			framework: kueue.KueueIntegrationMPIJob,
+			crd:       crdWithVersions("mpijobs.kubeflow.org", map[string]bool{"v1": true}),
+			want: kueue.IntegrationStatus{
+				Name:            kueue.KueueIntegrationMPIJob,
+				State:           kueue.IntegrationStateVersionMismatch,
+				ServedVersions:  []string{"v1"},
+				RequiredVersion: "v2beta1",
+			},
+		},
+		"CRD defines the supported version but does not serve it": {
+			framework: kueue.KueueIntegrationTrainJob,
+			crd:       crdWithVersions("trainjobs.trainer.kubeflow.org", map[string]bool{"v1alpha1": false, "v1beta1": true}),
+			want: kueue.IntegrationStatus{
+				Name:            kueue.KueueIntegrationTrainJob,
+				State:           kueue.IntegrationStateVersionMismatch,
+				ServedVersions:  []string{"v1beta1"},
+				RequiredVersion: "v1alpha1",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := CheckCRD(tc.framework, tc.crd)
+			if got.Message == "" {
+				t.Errorf("expected a message")
+			}
+			got.Message = ""
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("unexpected status (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSkipped(t *testing.T) {
+	statuses := []kueue.IntegrationStatus{
+		{Name: kueue.KueueIntegrationBatchJob, State: kueue.IntegrationStateAvailable},
+		{Name: kueue.KueueIntegrationRayJob, State: kueue.IntegrationStateMissing},
+		{Name: kueue.KueueIntegrationMPIJob, State: kueue.IntegrationStateVersionMismatch},
+		{Name: kueue.KueueIntegrationTrainJob, State: kueue.IntegrationStateVersionMismatch},
+	}
+	testCases := map[string]struct {
+		integrations kueue.Integrations
+		want         []kueue.KueueIntegration
+	}{
+		"default policy degrades": {
+			want: []kueue.KueueIntegration{},
+		},
+		"skip policy skips every unavailable integration": {
+			integrations: kueue.Integrations{MissingDependencyPolicy: kueue.MissingDependencyPolicySkip},
+			want:         []kueue.KueueIntegration{kueue.KueueIntegrationRayJob, kueue.KueueIntegrationMPIJob, kueue.KueueIntegrationTrainJob},
+		},
+		"incompatible integrations are opted out individually": {
+			integrations: kueue.Integrations{
+				MissingDependencyPolicy:    kueue.MissingDependencyPolicyDegrade,
+				SkipIncompatibleFrameworks: []kueue.KueueIntegration{kueue.KueueIntegrationTrainJob, kueue.KueueIntegrationRayJob},
+			},
+			want: []kueue.KueueIntegration{kueue.KueueIntegrationTrainJob},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := Skipped(tc.integrations, statuses)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("unexpected skipped integrations (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWithoutFrameworks(t *testing.T) {
+	cfg := kueue.KueueConfiguration{
+		Integrations: kueue.Integrations{
+			Frameworks: []kueue.KueueIntegration{
+				kueue.KueueIntegrationBatchJob,
+				kueue.KueueIntegrationRayJob,
+				kueue.KueueIntegrationMPIJob,
+				kueue.KueueIntegrationPod,
+			},
+		},
+	}
+
+	got := WithoutFrameworks(cfg, []kueue.KueueIntegration{kueue.KueueIntegrationRayJob, kueue.KueueIntegrationMPIJob})
+	want := []kueue.KueueIntegration{kueue.KueueIntegrationBatchJob, kueue.KueueIntegrationPod}
+	if diff := cmp.Diff(want, got.Integrations.Frameworks); diff != "" {
+		t.Errorf("unexpected frameworks (-want,+got):\n%s", diff)
+	}
+	if len(cfg.Integrations.Frameworks) != 4 {
+		t.Errorf("the original configuration was modified: %v", cfg.Integrations.Frameworks)
+	}
+}
+
+func TestEnabled(t *testing.T) {
+	testCases := map[string]struct {
+		frameworks []kueue.KueueIntegration
+		want       []kueue.KueueIntegration
+	}{
+		"no framework": {},
+		"no implicit pod": {
+			frameworks: []kueue.KueueIntegration{kueue.KueueIntegrationBatchJob, kueue.KueueIntegrationRayJob},
+			want:       []kueue.KueueIntegration{kueue.KueueIntegrationBatchJob, kueue.KueueIntegrationRayJob},
+		},
+		"implicit pod": {
+			frameworks: []kueue.KueueIntegration{kueue.KueueIntegrationBatchJob, kueue.KueueIntegrationDeployment, kueue.KueueIntegrationLeaderWorkerSet},
+			want:       []kueue.KueueIntegration{kueue.KueueIntegrationBatchJob, kueue.KueueIntegrationDeployment, kueue.KueueIntegrationLeaderWorkerSet, kueue.KueueIntegrationPod},
+		},
+		"explicit pod": {
+			frameworks: []kueue.KueueIntegration{kueue.KueueIntegrationPod, kueue.KueueIntegrationStatefulSet},
+			want:       []kueue.KueueIntegration{kueue.KueueIntegrationPod, kueue.KueueIntegrationStatefulSet},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, Enabled(tc.frameworks)); diff != "" {
+				t.Errorf("unexpected integrations (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
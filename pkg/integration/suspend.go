@@ -0,0 +1,68 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"encoding/json"
+	"fmt"
+
+	kueue "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Owner is a suspended object owning a Workload.
+type Owner struct {
+	API       API
+	Namespace string
+	Name      string
+}
+
+// String returns the resource, namespace and name of the owner.
+func (o Owner) String() string {
+	return fmt.Sprintf("%s %s/%s", o.API.GroupVersionResource().GroupResource(), o.Namespace, o.Name)
+}
+
+// OwnerOf returns the object owning the workload when it is the resource of one of the
+// frameworks suspended by Kueue, rather than gated.
+func OwnerOf(workload unstructured.Unstructured, frameworks []kueue.KueueIntegration) (Owner, bool) {
+	for _, ref := range workload.GetOwnerReferences() {
+		if ref.Controller == nil || !*ref.Controller {
+			continue
+		}
+		gv, err := schema.ParseGroupVersion(ref.APIVersion)
+		if err != nil {
+			return Owner{}, false
+		}
+		for _, framework := range frameworks {
+			api, ok := APIFor(framework)
+			if ok && len(api.SuspendPath) > 0 && api.Group == gv.Group && api.Kind == ref.Kind {
+				return Owner{API: api, Namespace: workload.GetNamespace(), Name: ref.Name}, true
+			}
+		}
+	}
+	return Owner{}, false
+}
+
+// UnsuspendPatch returns the merge patch unsuspending an object of the API.
+func UnsuspendPatch(api API) ([]byte, error) {
+	var patch interface{} = false
+	for i := len(api.SuspendPath) - 1; i >= 0; i-- {
+		patch = map[string]interface{}{api.SuspendPath[i]: patch}
+	}
+	return json.Marshal(patch)
+}
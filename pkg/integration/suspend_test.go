@@ -0,0 +1,90 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	kueue "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+)
+
+func ownedWorkload(owners ...interface{}) unstructured.Unstructured {
+	return unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":            "job-sample",
+			"namespace":       "team-a",
+			"ownerReferences": owners,
+		},
+	}}
+}
+
+func owner(apiVersion, kind string, controller bool) interface{} {
+	return map[string]interface{}{"apiVersion": apiVersion, "kind": kind, "name": "sample", "uid": "1", "controller": controller}
+}
+
+func TestOwnerOf(t *testing.T) {
+	frameworks := []kueue.KueueIntegration{kueue.KueueIntegrationBatchJob, kueue.KueueIntegrationPyTorchJob, kueue.KueueIntegrationPod}
+	testCases := map[string]struct {
+		owners    []interface{}
+		want      string
+		wantFound bool
+	}{
+		"job":              {owners: []interface{}{owner("batch/v1", "Job", true)}, want: "jobs.batch team-a/sample", wantFound: true},
+		"kubeflow job":     {owners: []interface{}{owner("kubeflow.org/v1", "PyTorchJob", true)}, want: "pytorchjobs.kubeflow.org team-a/sample", wantFound: true},
+		"not a controller": {owners: []interface{}{owner("batch/v1", "Job", false)}},
+		"disabled framework": {
+			owners: []interface{}{owner("jobset.x-k8s.io/v1alpha2", "JobSet", true)},
+		},
+		"gated pod": {owners: []interface{}{owner("v1", "Pod", true)}},
+		"no owner":  {},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, found := OwnerOf(ownedWorkload(tc.owners...), frameworks)
+			if found != tc.wantFound {
+				t.Fatalf("OwnerOf() found = %v, want %v", found, tc.wantFound)
+			}
+			if found && got.String() != tc.want {
+				t.Errorf("OwnerOf() = %q, want %q", got.String(), tc.want)
+			}
+		})
+	}
+}
+
+func TestUnsuspendPatch(t *testing.T) {
+	testCases := map[kueue.KueueIntegration]string{
+		kueue.KueueIntegrationBatchJob: `{"spec":{"suspend":false}}`,
+		kueue.KueueIntegrationMPIJob:   `{"spec":{"runPolicy":{"suspend":false}}}`,
+	}
+
+	for framework, want := range testCases {
+		t.Run(string(framework), func(t *testing.T) {
+			api, _ := APIFor(framework)
+			got, err := UnsuspendPatch(api)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want, string(got)); diff != "" {
+				t.Errorf("unexpected patch (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
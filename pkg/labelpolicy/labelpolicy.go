@@ -0,0 +1,114 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package labelpolicy previews the objects that become managed by Kueue when the label
+// policy changes from QueueName to None, and decides whether the change is applied.
+package labelpolicy
+
+import (
+	"cmp"
+	"slices"
+
+	kueue "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+	"github.com/openshift/kueue-operator/pkg/queueassignment"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// PreviewAnnotation previews a label policy without changing it, when set on the
+	// Kueue CR.
+	PreviewAnnotation = "kueue.openshift.io/preview-label-policy"
+	// AcknowledgeAnnotation acknowledges a change of the label policy, when set on the
+	// Kueue CR.
+	AcknowledgeAnnotation = "kueue.openshift.io/acknowledge-label-policy"
+	// maxPreviewObjects bounds the number of counts reported in the preview.
+	maxPreviewObjects = 64
+)
+
+// Desired returns the label policy of the Kueue CR.
+func Desired(k *kueue.Kueue) kueue.LabelPolicy {
+	if k.Spec.Config.WorkloadManagement.LabelPolicy == kueue.LabelPolicyNone {
+		return kueue.LabelPolicyNone
+	}
+	return kueue.LabelPolicyQueueName
+}
+
+// Held reports whether the change from the applied label policy to the one of the
+// Kueue CR waits for an acknowledgement.
+func Held(k *kueue.Kueue, applied kueue.LabelPolicy) bool {
+	return applied == kueue.LabelPolicyQueueName &&
+		Desired(k) == kueue.LabelPolicyNone &&
+		k.Spec.Config.WorkloadManagement.LabelPolicySwitch == kueue.LabelPolicySwitchRequireAcknowledgement &&
+		k.Annotations[AcknowledgeAnnotation] != string(kueue.LabelPolicyNone)
+}
+
+// Previewed reports whether the objects that would become managed with the None label
+// policy are previewed: the applied label policy is QueueName, and None is either the
+// label policy of the Kueue CR or previewed with the annotation.
+func Previewed(k *kueue.Kueue, applied kueue.LabelPolicy) bool {
+	return applied == kueue.LabelPolicyQueueName &&
+		(Desired(k) == kueue.LabelPolicyNone || k.Annotations[PreviewAnnotation] == string(kueue.LabelPolicyNone))
+}
+
+// BecomesManaged reports whether Kueue would manage the object with the None label
+// policy: it does not set a queue name, and is not owned by another object, whose
+// workload would include it.
+func BecomesManaged(obj unstructured.Unstructured) bool {
+	if _, found := obj.GetLabels()[queueassignment.QueueNameLabel]; found {
+		return false
+	}
+	return metav1.GetControllerOfNoCopy(&obj) == nil
+}
+
+// Key identifies the objects of an integration in a namespace.
+type Key struct {
+	Namespace   string
+	Integration kueue.KueueIntegration
+}
+
+// BuildPreview returns the preview of the None label policy from the number of objects
+// that would become managed, the largest counts first.
+func BuildPreview(counts map[Key]int32, now metav1.Time) kueue.LabelPolicyPreview {
+	preview := kueue.LabelPolicyPreview{
+		LabelPolicy:    kueue.LabelPolicyNone,
+		Total:          ptr.To[int32](0),
+		LastUpdateTime: now,
+	}
+	for key, count := range counts {
+		if count == 0 {
+			continue
+		}
+		*preview.Total += count
+		preview.Objects = append(preview.Objects, kueue.LabelPolicyPreviewObjects{
+			Namespace:   key.Namespace,
+			Integration: key.Integration,
+			Count:       count,
+		})
+	}
+	slices.SortFunc(preview.Objects, func(a, b kueue.LabelPolicyPreviewObjects) int {
+		return cmp.Or(
+			cmp.Compare(b.Count, a.Count),
+			cmp.Compare(a.Namespace, b.Namespace),
+			cmp.Compare(a.Integration, b.Integration),
+		)
+	})
+	if len(preview.Objects) > maxPreviewObjects {
+		preview.Objects = preview.Objects[:maxPreviewObjects]
+	}
+	return preview
+}
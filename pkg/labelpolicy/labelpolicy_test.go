@@ -0,0 +1,152 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package labelpolicy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+
+	kueue "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+)
+
+func testKueue(policy kueue.LabelPolicy, policySwitch kueue.LabelPolicySwitch, annotations map[string]string) *kueue.Kueue {
+	return &kueue.Kueue{
+		ObjectMeta: metav1.ObjectMeta{Annotations: annotations},
+		Spec: kueue.KueueOperandSpec{
+			Config: kueue.KueueConfiguration{
+				WorkloadManagement: kueue.WorkloadManagement{LabelPolicy: policy, LabelPolicySwitch: policySwitch},
+			},
+		},
+	}
+}
+
+func TestHeldAndPreviewed(t *testing.T) {
+	testCases := map[string]struct {
+		kueue         *kueue.Kueue
+		applied       kueue.LabelPolicy
+		wantHeld      bool
+		wantPreviewed bool
+	}{
+		"no change": {
+			kueue:   testKueue("", "", nil),
+			applied: kueue.LabelPolicyQueueName,
+		},
+		"preview annotation": {
+			kueue:         testKueue(kueue.LabelPolicyQueueName, "", map[string]string{PreviewAnnotation: "None"}),
+			applied:       kueue.LabelPolicyQueueName,
+			wantPreviewed: true,
+		},
+		"immediate change": {
+			kueue:         testKueue(kueue.LabelPolicyNone, "", nil),
+			applied:       kueue.LabelPolicyQueueName,
+			wantPreviewed: true,
+		},
+		"change requiring an acknowledgement": {
+			kueue:         testKueue(kueue.LabelPolicyNone, kueue.LabelPolicySwitchRequireAcknowledgement, nil),
+			applied:       kueue.LabelPolicyQueueName,
+			wantHeld:      true,
+			wantPreviewed: true,
+		},
+		"acknowledged change": {
+			kueue:         testKueue(kueue.LabelPolicyNone, kueue.LabelPolicySwitchRequireAcknowledgement, map[string]string{AcknowledgeAnnotation: "None"}),
+			applied:       kueue.LabelPolicyQueueName,
+			wantPreviewed: true,
+		},
+		"applied change": {
+			kueue:   testKueue(kueue.LabelPolicyNone, kueue.LabelPolicySwitchRequireAcknowledgement, nil),
+			applied: kueue.LabelPolicyNone,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := Held(tc.kueue, tc.applied); got != tc.wantHeld {
+				t.Errorf("Held() = %v, want %v", got, tc.wantHeld)
+			}
+			if got := Previewed(tc.kueue, tc.applied); got != tc.wantPreviewed {
+				t.Errorf("Previewed() = %v, want %v", got, tc.wantPreviewed)
+			}
+		})
+	}
+}
+
+func TestBecomesManaged(t *testing.T) {
+	testCases := map[string]struct {
+		metadata map[string]interface{}
+		want     bool
+	}{
+		"without queue name": {
+			metadata: map[string]interface{}{"name": "sample"},
+			want:     true,
+		},
+		"with queue name": {
+			metadata: map[string]interface{}{"name": "sample", "labels": map[string]interface{}{"kueue.x-k8s.io/queue-name": "default"}},
+		},
+		"owned": {
+			metadata: map[string]interface{}{
+				"name":            "sample",
+				"ownerReferences": []interface{}{map[string]interface{}{"apiVersion": "jobset.x-k8s.io/v1alpha2", "kind": "JobSet", "name": "sample", "uid": "1", "controller": true}},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			obj := unstructured.Unstructured{Object: map[string]interface{}{"metadata": tc.metadata}}
+			if got := BecomesManaged(obj); got != tc.want {
+				t.Errorf("BecomesManaged() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBuildPreview(t *testing.T) {
+	now := metav1.Now()
+	got := BuildPreview(map[Key]int32{
+		{Namespace: "team-b", Integration: kueue.KueueIntegrationBatchJob}: 2,
+		{Namespace: "team-a", Integration: kueue.KueueIntegrationRayJob}:   5,
+		{Namespace: "team-a", Integration: kueue.KueueIntegrationBatchJob}: 2,
+		{Namespace: "team-c", Integration: kueue.KueueIntegrationBatchJob}: 0,
+	}, now)
+	want := kueue.LabelPolicyPreview{
+		LabelPolicy: kueue.LabelPolicyNone,
+		Total:       ptr.To[int32](9),
+		Objects: []kueue.LabelPolicyPreviewObjects{
+			{Namespace: "team-a", Integration: kueue.KueueIntegrationRayJob, Count: 5},
+			{Namespace: "team-a", Integration: kueue.KueueIntegrationBatchJob, Count: 2},
+			{Namespace: "team-b", Integration: kueue.KueueIntegrationBatchJob, Count: 2},
+		},
+		LastUpdateTime: now,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected preview (-want,+got):\n%s", diff)
+	}
+
+	counts := map[Key]int32{}
+	for i := range 100 {
+		counts[Key{Namespace: fmt.Sprintf("team-%d", i), Integration: kueue.KueueIntegrationBatchJob}] = 1
+	}
+	got = BuildPreview(counts, now)
+	if *got.Total != 100 || len(got.Objects) != maxPreviewObjects {
+		t.Errorf("unexpected preview of %d objects with %d counts", *got.Total, len(got.Objects))
+	}
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package limitrange builds the LimitRanges setting the default requests and limits of
+// the containers of managed namespaces.
+package limitrange
+
+import (
+	"fmt"
+
+	kueue "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// Name is the name of the LimitRange created in every managed namespace.
+	Name = "kueue-container-defaults"
+	// ManagedByValue is the value of the managed-by label of the LimitRanges created
+	// by the operator.
+	ManagedByValue = "kueue-operator-container-defaults"
+)
+
+// Selector selects the LimitRanges created by the operator.
+func Selector() string {
+	return kueue.ManagedByLabel + "=" + ManagedByValue
+}
+
+// IsManaged reports whether the LimitRange was created by the operator.
+func IsManaged(limitRange *corev1.LimitRange) bool {
+	return limitRange.Labels[kueue.ManagedByLabel] == ManagedByValue
+}
+
+// Build returns the LimitRange of the namespace.
+func Build(defaults kueue.ContainerDefaults, namespace string) *corev1.LimitRange {
+	item := corev1.LimitRangeItem{Type: corev1.LimitTypeContainer}
+	for _, r := range defaults.Resources {
+		name := corev1.ResourceName(r.Name)
+		if r.DefaultRequest != nil {
+			if item.DefaultRequest == nil {
+				item.DefaultRequest = corev1.ResourceList{}
+			}
+			item.DefaultRequest[name] = r.DefaultRequest.DeepCopy()
+		}
+		if r.DefaultLimit != nil {
+			if item.Default == nil {
+				item.Default = corev1.ResourceList{}
+			}
+			item.Default[name] = r.DefaultLimit.DeepCopy()
+		}
+	}
+	return &corev1.LimitRange{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      Name,
+			Namespace: namespace,
+			Labels:    map[string]string{kueue.ManagedByLabel: ManagedByValue},
+		},
+		Spec: corev1.LimitRangeSpec{Limits: []corev1.LimitRangeItem{item}},
+	}
+}
+
+// Conflict returns why the LimitRanges not created by the operator in a namespace
+// conflict with the defaults, or an empty string when they do not. Defaults set by
+// several LimitRanges are applied in an unspecified order, and defaults outside of the
+// bounds of another LimitRange would make every pod relying on them be rejected.
+func Conflict(defaults kueue.ContainerDefaults, limitRanges []*corev1.LimitRange) string {
+	for _, limitRange := range limitRanges {
+		if IsManaged(limitRange) {
+			continue
+		}
+		for _, item := range limitRange.Spec.Limits {
+			if item.Type != corev1.LimitTypeContainer {
+				continue
+			}
+			for _, r := range defaults.Resources {
+				name := corev1.ResourceName(r.Name)
+				if _, found := item.Default[name]; found {
+					return fmt.Sprintf("LimitRange %s sets the default limit of %s", limitRange.Name, name)
+				}
+				if _, found := item.DefaultRequest[name]; found {
+					return fmt.Sprintf("LimitRange %s sets the default request of %s", limitRange.Name, name)
+				}
+				for _, value := range []*resource.Quantity{r.DefaultRequest, r.DefaultLimit} {
+					if value == nil {
+						continue
+					}
+					if bound, found := item.Min[name]; found && value.Cmp(bound) < 0 {
+						return fmt.Sprintf("LimitRange %s requires at least %s of %s", limitRange.Name, bound.String(), name)
+					}
+					if bound, found := item.Max[name]; found && value.Cmp(bound) > 0 {
+						return fmt.Sprintf("LimitRange %s allows at most %s of %s", limitRange.Name, bound.String(), name)
+					}
+				}
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package limitrange
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	kueue "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+)
+
+func testDefaults() kueue.ContainerDefaults {
+	return kueue.ContainerDefaults{
+		Resources: []kueue.ContainerResourceDefault{
+			{Name: "cpu", DefaultRequest: ptr.To(resource.MustParse("100m")), DefaultLimit: ptr.To(resource.MustParse("1"))},
+			{Name: "memory", DefaultRequest: ptr.To(resource.MustParse("256Mi"))},
+		},
+	}
+}
+
+func TestBuild(t *testing.T) {
+	got := Build(testDefaults(), "team-a")
+	want := corev1.LimitRangeSpec{
+		Limits: []corev1.LimitRangeItem{
+			{
+				Type:           corev1.LimitTypeContainer,
+				Default:        corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+				DefaultRequest: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m"), corev1.ResourceMemory: resource.MustParse("256Mi")},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, got.Spec); diff != "" {
+		t.Errorf("unexpected spec (-want,+got):\n%s", diff)
+	}
+	if got.Namespace != "team-a" || !IsManaged(got) {
+		t.Errorf("unexpected metadata: %v", got.ObjectMeta)
+	}
+}
+
+func TestConflict(t *testing.T) {
+	limitRange := func(name string, item corev1.LimitRangeItem) *corev1.LimitRange {
+		return &corev1.LimitRange{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       corev1.LimitRangeSpec{Limits: []corev1.LimitRangeItem{item}},
+		}
+	}
+
+	testCases := map[string]struct {
+		limitRanges []*corev1.LimitRange
+		want        string
+	}{
+		"no LimitRange": {},
+		"LimitRange created by the operator": {
+			limitRanges: []*corev1.LimitRange{Build(testDefaults(), "team-a")},
+		},
+		"defaults of other resources": {
+			limitRanges: []*corev1.LimitRange{
+				limitRange("user", corev1.LimitRangeItem{
+					Type:    corev1.LimitTypeContainer,
+					Default: corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("1")},
+				}),
+			},
+		},
+		"pod limits": {
+			limitRanges: []*corev1.LimitRange{
+				limitRange("user", corev1.LimitRangeItem{
+					Type: corev1.LimitTypePod,
+					Max:  corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("10m")},
+				}),
+			},
+		},
+		"same default": {
+			limitRanges: []*corev1.LimitRange{
+				limitRange("user", corev1.LimitRangeItem{
+					Type:           corev1.LimitTypeContainer,
+					DefaultRequest: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+				}),
+			},
+			want: "LimitRange user sets the default request of memory",
+		},
+		"default above the maximum": {
+			limitRanges: []*corev1.LimitRange{
+				limitRange("user", corev1.LimitRangeItem{
+					Type: corev1.LimitTypeContainer,
+					Max:  corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")},
+				}),
+			},
+			want: "LimitRange user allows at most 500m of cpu",
+		},
+		"default below the minimum": {
+			limitRanges: []*corev1.LimitRange{
+				limitRange("user", corev1.LimitRangeItem{
+					Type: corev1.LimitTypeContainer,
+					Min:  corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("512Mi")},
+				}),
+			},
+			want: "LimitRange user requires at least 512Mi of memory",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := Conflict(testDefaults(), tc.limitRanges); got != tc.want {
+				t.Errorf("Conflict() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
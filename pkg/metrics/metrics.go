@@ -0,0 +1,176 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package metrics defines the metrics the operator reports about its own reconciliations.
+// They are registered in the legacy registry of component-base, served with delegated
+// authentication and authorization on the /metrics endpoint of the operator.
+package metrics
+
+import (
+	"time"
+
+	"k8s.io/component-base/metrics"
+	"k8s.io/component-base/metrics/legacyregistry"
+)
+
+const (
+	// ScopeFull is the scope of the reconciliations syncing all the sub-controllers.
+	ScopeFull = "full"
+	// ScopeTargeted is the scope of the reconciliations syncing only the sub-controllers
+	// of the changed objects.
+	ScopeTargeted = "targeted"
+)
+
+var (
+	reconciles = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Name:           "openshift_kueue_operator_reconciles_total",
+			Help:           "Number of reconciliations of Kueue by scope: full, or targeted to the sub-controllers of the changed objects.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"scope"},
+	)
+	syncDuration = metrics.NewHistogramVec(
+		&metrics.HistogramOpts{
+			Name:           "openshift_kueue_operator_sync_duration_seconds",
+			Help:           "Duration of the reconciliations of Kueue by result: success or error.",
+			Buckets:        metrics.ExponentialBuckets(0.05, 2, 14),
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"result"},
+	)
+	subControllerSyncs = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Name:           "openshift_kueue_operator_subcontroller_syncs_total",
+			Help:           "Number of reconciliations that synced or skipped each sub-controller. Skipped syncs are the work saved by targeted reconciliations.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"subcontroller", "result"},
+	)
+	applyPhaseDuration = metrics.NewHistogramVec(
+		&metrics.HistogramOpts{
+			Name:           "openshift_kueue_operator_apply_phase_duration_seconds",
+			Help:           "Duration of the phases applying independent objects concurrently.",
+			Buckets:        metrics.ExponentialBuckets(0.05, 2, 12),
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"phase"},
+	)
+	applies = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Name:           "openshift_kueue_operator_applies_total",
+			Help:           "Number of objects applied to the API server by kind.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"kind"},
+	)
+	resourceCacheLookups = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Name:           "openshift_kueue_operator_resource_cache_lookups_total",
+			Help:           "Number of lookups of the resource cache by kind and result: hit when the apply is skipped, miss otherwise.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"kind", "result"},
+	)
+	certificateWaitDuration = metrics.NewHistogramVec(
+		&metrics.HistogramOpts{
+			Name:           "openshift_kueue_operator_certificate_wait_duration_seconds",
+			Help:           "Duration of the waits for the certificates of Kueue to be issued, by certificate and result: ready or not_ready.",
+			Buckets:        metrics.ExponentialBuckets(0.1, 2, 12),
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"certificate", "result"},
+	)
+	operandRollouts = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Name:           "openshift_kueue_operator_operand_rollouts_total",
+			Help:           "Number of rollouts of the Kueue deployment by the spec hash annotation that changed. A rollout changing several hashes is counted once for each.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"trigger"},
+	)
+)
+
+func init() {
+	legacyregistry.MustRegister(
+		reconciles,
+		syncDuration,
+		subControllerSyncs,
+		applyPhaseDuration,
+		applies,
+		resourceCacheLookups,
+		certificateWaitDuration,
+		operandRollouts,
+	)
+}
+
+// RecordReconcile counts a reconciliation of the given scope.
+func RecordReconcile(scope string) {
+	reconciles.WithLabelValues(scope).Inc()
+}
+
+// ObserveSync records the duration of a reconciliation and whether it failed.
+func ObserveSync(duration time.Duration, err error) {
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+	syncDuration.WithLabelValues(result).Observe(duration.Seconds())
+}
+
+// RecordSubControllerSync counts a sub-controller synced or skipped by a reconciliation.
+func RecordSubControllerSync(subController string, skipped bool) {
+	result := "synced"
+	if skipped {
+		result = "skipped"
+	}
+	subControllerSyncs.WithLabelValues(subController, result).Inc()
+}
+
+// ObserveApplyPhase records the duration of an apply phase.
+func ObserveApplyPhase(phase string, duration time.Duration) {
+	applyPhaseDuration.WithLabelValues(phase).Observe(duration.Seconds())
+}
+
+// RecordApply counts an object of the given kind applied to the API server.
+func RecordApply(kind string) {
+	applies.WithLabelValues(kind).Inc()
+}
+
+// RecordResourceCacheLookup counts a lookup of the resource cache, a hit skipping the
+// apply of the object.
+func RecordResourceCacheLookup(kind string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	resourceCacheLookups.WithLabelValues(kind, result).Inc()
+}
+
+// ObserveCertificateWait records the duration of a wait for a certificate to be issued.
+func ObserveCertificateWait(certificate string, duration time.Duration, ready bool) {
+	result := "not_ready"
+	if ready {
+		result = "ready"
+	}
+	certificateWaitDuration.WithLabelValues(certificate, result).Observe(duration.Seconds())
+}
+
+// RecordOperandRollout counts a rollout of the Kueue deployment triggered by the change
+// of the given spec hash annotation.
+func RecordOperandRollout(trigger string) {
+	operandRollouts.WithLabelValues(trigger).Inc()
+}
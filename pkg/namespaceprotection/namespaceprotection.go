@@ -0,0 +1,180 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package namespaceprotection builds the ValidatingAdmissionPolicy restricting the
+// changes to the namespace labels that opt namespaces in Kueue management.
+package namespaceprotection
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	kueue "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// PolicyName is the name of the ValidatingAdmissionPolicy, and of its binding.
+	PolicyName = "kueue-namespace-protection"
+	// AdmittedWorkloadsAnnotation is set to "true" by the operator on the namespaces
+	// with admitted workloads.
+	AdmittedWorkloadsAnnotation = "kueue.openshift.io/admitted-workloads"
+)
+
+// LabelKeys returns the protected labels.
+func LabelKeys(protection kueue.NamespaceProtection) []string {
+	if len(protection.LabelKeys) == 0 {
+		return []string{kueue.ManagedNamespaceLabel}
+	}
+	return protection.LabelKeys
+}
+
+// Enabled reports whether the namespace protection is configured.
+func Enabled(protection kueue.NamespaceProtection) bool {
+	return len(protection.AllowedGroups) > 0
+}
+
+// BlocksRemoval reports whether the protected labels may not be removed while the
+// namespace has admitted workloads.
+func BlocksRemoval(protection kueue.NamespaceProtection) bool {
+	return Enabled(protection) && protection.LabelRemovalPolicy != kueue.LabelRemovalPolicyAllow
+}
+
+// NamespacesWithAdmittedWorkloads returns the namespaces of the LocalQueues with
+// admitted workloads.
+func NamespacesWithAdmittedWorkloads(localQueues []unstructured.Unstructured) sets.Set[string] {
+	namespaces := sets.New[string]()
+	for _, localQueue := range localQueues {
+		admitted, _, _ := unstructured.NestedInt64(localQueue.Object, "status", "admittedWorkloads")
+		if admitted > 0 {
+			namespaces.Insert(localQueue.GetNamespace())
+		}
+	}
+	return namespaces
+}
+
+func celString(s string) string {
+	return strconv.Quote(s)
+}
+
+func celList(values []string) string {
+	literals := make([]string, 0, len(values))
+	for _, value := range values {
+		literals = append(literals, celString(value))
+	}
+	return "[" + strings.Join(literals, ", ") + "]"
+}
+
+// celValue returns a CEL expression evaluating to the value of the label, or
+// annotation, key of obj, or to an empty string when obj does not have it.
+func celValue(obj, field, key string) string {
+	return fmt.Sprintf("(has(%[1]s.metadata.%[2]s) && %[3]s in %[1]s.metadata.%[2]s ? %[1]s.metadata.%[2]s[%[3]s] : \"\")", obj, field, key)
+}
+
+// BuildValidatingAdmissionPolicy returns the ValidatingAdmissionPolicy protecting the
+// labels, and its binding. The requests of operatorUser and of the members of
+// system:masters are not validated.
+func BuildValidatingAdmissionPolicy(protection kueue.NamespaceProtection, operatorUser string) (*admissionregistrationv1.ValidatingAdmissionPolicy, *admissionregistrationv1.ValidatingAdmissionPolicyBinding) {
+	keys := celList(LabelKeys(protection))
+	variables := []admissionregistrationv1.Variable{
+		{
+			Name:       "labelsChanged",
+			Expression: fmt.Sprintf("%s.exists(k, %s != %s)", keys, celValue("object", "labels", "k"), celValue("oldObject", "labels", "k")),
+		},
+		{
+			Name:       "allowed",
+			Expression: fmt.Sprintf("has(request.userInfo.groups) && request.userInfo.groups.exists(g, g in %s)", celList(protection.AllowedGroups)),
+		},
+	}
+	validations := []admissionregistrationv1.Validation{
+		{
+			Expression: "!variables.labelsChanged || variables.allowed",
+			Message:    fmt.Sprintf("only the members of %s may change the namespace labels %s", strings.Join(protection.AllowedGroups, ", "), strings.Join(LabelKeys(protection), ", ")),
+			Reason:     ptr.To(metav1.StatusReasonForbidden),
+		},
+	}
+	if BlocksRemoval(protection) {
+		annotation := celString(AdmittedWorkloadsAnnotation)
+		variables = append(variables,
+			admissionregistrationv1.Variable{
+				Name:       "labelsRemoved",
+				Expression: fmt.Sprintf("%s.exists(k, %s != \"\" && %s != %s)", keys, celValue("oldObject", "labels", "k"), celValue("object", "labels", "k"), celValue("oldObject", "labels", "k")),
+			},
+			admissionregistrationv1.Variable{
+				Name:       "admitted",
+				Expression: fmt.Sprintf("%s == \"true\"", celValue("oldObject", "annotations", annotation)),
+			},
+		)
+		validations = append(validations,
+			admissionregistrationv1.Validation{
+				Expression: "!variables.labelsRemoved || !variables.admitted",
+				Message:    fmt.Sprintf("the namespace labels %s can not be removed while the namespace has admitted workloads", strings.Join(LabelKeys(protection), ", ")),
+				Reason:     ptr.To(metav1.StatusReasonForbidden),
+			},
+			admissionregistrationv1.Validation{
+				Expression: fmt.Sprintf("%s == %s", celValue("object", "annotations", annotation), celValue("oldObject", "annotations", annotation)),
+				Message:    fmt.Sprintf("the %s annotation is managed by the Kueue operator", AdmittedWorkloadsAnnotation),
+				Reason:     ptr.To(metav1.StatusReasonForbidden),
+			},
+		)
+	}
+
+	policy := &admissionregistrationv1.ValidatingAdmissionPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: PolicyName},
+		Spec: admissionregistrationv1.ValidatingAdmissionPolicySpec{
+			MatchConstraints: &admissionregistrationv1.MatchResources{
+				NamespaceSelector: &metav1.LabelSelector{},
+				ObjectSelector:    &metav1.LabelSelector{},
+				ResourceRules: []admissionregistrationv1.NamedRuleWithOperations{
+					{
+						RuleWithOperations: admissionregistrationv1.RuleWithOperations{
+							Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Update},
+							Rule: admissionregistrationv1.Rule{
+								APIGroups:   []string{""},
+								APIVersions: []string{"v1"},
+								Resources:   []string{"namespaces"},
+								Scope:       ptr.To(admissionregistrationv1.ClusterScope),
+							},
+						},
+					},
+				},
+				MatchPolicy: ptr.To(admissionregistrationv1.Equivalent),
+			},
+			MatchConditions: []admissionregistrationv1.MatchCondition{
+				{
+					Name:       "not-exempt",
+					Expression: fmt.Sprintf("request.userInfo.username != %s && !(has(request.userInfo.groups) && \"system:masters\" in request.userInfo.groups)", celString(operatorUser)),
+				},
+			},
+			Variables:     variables,
+			Validations:   validations,
+			FailurePolicy: ptr.To(admissionregistrationv1.Fail),
+		},
+	}
+	binding := &admissionregistrationv1.ValidatingAdmissionPolicyBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: PolicyName},
+		Spec: admissionregistrationv1.ValidatingAdmissionPolicyBindingSpec{
+			PolicyName:        PolicyName,
+			ValidationActions: []admissionregistrationv1.ValidationAction{admissionregistrationv1.Deny},
+		},
+	}
+	return policy, binding
+}
@@ -0,0 +1,224 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package namespaceprotection
+
+import (
+	"testing"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	kueue "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+)
+
+const operatorUser = "system:serviceaccount:openshift-kueue-operator:openshift-kueue-operator"
+
+type namespace struct {
+	labels      map[string]string
+	annotations map[string]string
+}
+
+func (ns namespace) toMap() map[string]interface{} {
+	metadata := map[string]interface{}{}
+	for field, values := range map[string]map[string]string{"labels": ns.labels, "annotations": ns.annotations} {
+		if values == nil {
+			continue
+		}
+		m := map[string]interface{}{}
+		for key, value := range values {
+			m[key] = value
+		}
+		metadata[field] = m
+	}
+	return map[string]interface{}{"metadata": metadata}
+}
+
+// evalPolicy evaluates the ValidatingAdmissionPolicy the way the API server does, and
+// returns the messages of the failed validations.
+func evalPolicy(t *testing.T, protection kueue.NamespaceProtection, username string, groups []string, oldNamespace, newNamespace namespace) []string {
+	t.Helper()
+	policy, _ := BuildValidatingAdmissionPolicy(protection, operatorUser)
+	env, err := cel.NewEnv(
+		cel.Variable("object", cel.DynType),
+		cel.Variable("oldObject", cel.DynType),
+		cel.Variable("request", cel.DynType),
+		cel.Variable("variables", cel.MapType(cel.StringType, cel.DynType)),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	eval := func(expression string, activation map[string]interface{}) bool {
+		ast, issues := env.Compile(expression)
+		if issues.Err() != nil {
+			t.Fatalf("%s does not compile: %v", expression, issues.Err())
+		}
+		program, err := env.Program(ast)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		value, _, err := program.Eval(activation)
+		if err != nil {
+			t.Fatalf("%s failed: %v", expression, err)
+		}
+		return value.Value().(bool)
+	}
+
+	userGroups := []interface{}{}
+	for _, group := range groups {
+		userGroups = append(userGroups, group)
+	}
+	variables := map[string]interface{}{}
+	activation := map[string]interface{}{
+		"object":    newNamespace.toMap(),
+		"oldObject": oldNamespace.toMap(),
+		"request":   map[string]interface{}{"userInfo": map[string]interface{}{"username": username, "groups": userGroups}},
+		"variables": variables,
+	}
+	messages := []string{}
+	for _, condition := range policy.Spec.MatchConditions {
+		if !eval(condition.Expression, activation) {
+			return messages
+		}
+	}
+	for _, variable := range policy.Spec.Variables {
+		variables[variable.Name] = eval(variable.Expression, activation)
+	}
+	for _, validation := range policy.Spec.Validations {
+		if !eval(validation.Expression, activation) {
+			messages = append(messages, validation.Message)
+		}
+	}
+	return messages
+}
+
+func TestBuildValidatingAdmissionPolicy(t *testing.T) {
+	managed := map[string]string{kueue.ManagedNamespaceLabel: "true", "team": "research"}
+	unmanaged := map[string]string{"team": "research"}
+	admitted := map[string]string{AdmittedWorkloadsAnnotation: "true"}
+	forbiddenChange := "only the members of cluster-admins may change the namespace labels kueue.openshift.io/managed"
+	forbiddenRemoval := "the namespace labels kueue.openshift.io/managed can not be removed while the namespace has admitted workloads"
+
+	testCases := map[string]struct {
+		protection   kueue.NamespaceProtection
+		username     string
+		groups       []string
+		oldNamespace namespace
+		newNamespace namespace
+		want         []string
+	}{
+		"other labels may be changed": {
+			groups:       []string{"project-admins"},
+			oldNamespace: namespace{labels: managed},
+			newNamespace: namespace{labels: map[string]string{kueue.ManagedNamespaceLabel: "true", "team": "finance"}},
+		},
+		"opting in requires an allowed group": {
+			groups:       []string{"project-admins"},
+			oldNamespace: namespace{labels: unmanaged},
+			newNamespace: namespace{labels: managed},
+			want:         []string{forbiddenChange},
+		},
+		"opting out requires an allowed group": {
+			groups:       []string{"project-admins"},
+			oldNamespace: namespace{labels: managed},
+			newNamespace: namespace{labels: unmanaged},
+			want:         []string{forbiddenChange},
+		},
+		"allowed groups may opt in": {
+			groups:       []string{"cluster-admins"},
+			oldNamespace: namespace{labels: unmanaged},
+			newNamespace: namespace{labels: managed},
+		},
+		"allowed groups may opt out without admitted workloads": {
+			groups:       []string{"cluster-admins"},
+			oldNamespace: namespace{labels: managed},
+			newNamespace: namespace{labels: unmanaged},
+		},
+		"opting out is blocked with admitted workloads": {
+			groups:       []string{"cluster-admins"},
+			oldNamespace: namespace{labels: managed, annotations: admitted},
+			newNamespace: namespace{labels: unmanaged, annotations: admitted},
+			want:         []string{forbiddenRemoval},
+		},
+		"opting out with admitted workloads when removal is allowed": {
+			protection:   kueue.NamespaceProtection{LabelRemovalPolicy: kueue.LabelRemovalPolicyAllow},
+			groups:       []string{"cluster-admins"},
+			oldNamespace: namespace{labels: managed, annotations: admitted},
+			newNamespace: namespace{labels: unmanaged, annotations: admitted},
+		},
+		"the annotation is managed by the operator": {
+			groups:       []string{"cluster-admins"},
+			oldNamespace: namespace{labels: managed, annotations: admitted},
+			newNamespace: namespace{labels: unmanaged},
+			want:         []string{forbiddenRemoval, "the kueue.openshift.io/admitted-workloads annotation is managed by the Kueue operator"},
+		},
+		"the operator is exempt": {
+			username:     operatorUser,
+			oldNamespace: namespace{labels: managed, annotations: admitted},
+			newNamespace: namespace{labels: managed},
+		},
+		"system:masters is exempt": {
+			groups:       []string{"system:masters"},
+			oldNamespace: namespace{labels: managed, annotations: admitted},
+			newNamespace: namespace{labels: unmanaged},
+		},
+		"configured label keys": {
+			protection:   kueue.NamespaceProtection{LabelKeys: []string{"team"}},
+			groups:       []string{"project-admins"},
+			oldNamespace: namespace{labels: managed},
+			newNamespace: namespace{labels: unmanaged},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			protection := tc.protection
+			protection.AllowedGroups = []string{"cluster-admins"}
+			got := evalPolicy(t, protection, tc.username, tc.groups, tc.oldNamespace, tc.newNamespace)
+			if diff := cmp.Diff(tc.want, got, cmp.Transformer("nil", func(s []string) []string {
+				if len(s) == 0 {
+					return nil
+				}
+				return s
+			})); diff != "" {
+				t.Errorf("unexpected messages (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNamespacesWithAdmittedWorkloads(t *testing.T) {
+	localQueue := func(namespace string, admitted int64) unstructured.Unstructured {
+		obj := unstructured.Unstructured{Object: map[string]interface{}{}}
+		obj.SetNamespace(namespace)
+		if admitted >= 0 {
+			_ = unstructured.SetNestedField(obj.Object, admitted, "status", "admittedWorkloads")
+		}
+		return obj
+	}
+	got := NamespacesWithAdmittedWorkloads([]unstructured.Unstructured{
+		localQueue("team-a", 0),
+		localQueue("team-a", 2),
+		localQueue("team-b", 0),
+		localQueue("team-c", -1),
+		localQueue("team-d", 1),
+	})
+	if diff := cmp.Diff(sets.New("team-a", "team-d"), got); diff != "" {
+		t.Errorf("unexpected namespaces (-want,+got):\n%s", diff)
+	}
+}
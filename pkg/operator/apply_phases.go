@@ -0,0 +1,88 @@
+package operator
+
+import (
+	"context"
+	"maps"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/openshift/kueue-operator/pkg/metrics"
+	"github.com/openshift/library-go/pkg/operator/resource/resourceapply"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilerror "k8s.io/apimachinery/pkg/util/errors"
+)
+
+// maxConcurrentApplies bounds the steps in flight across all the apply phases, so that a
+// cold start does not flood a busy API server.
+const maxConcurrentApplies = 8
+
+// applyStep applies objects that do not depend on the other steps of its phase, and
+// records in specAnnotations the hashes the Kueue deployment is rolled out on.
+type applyStep func(ctx context.Context, specAnnotations map[string]string) error
+
+// runApplyPhase runs the steps of a phase concurrently and waits for all of them. Each
+// step records its hashes in its own annotations, merged into specAnnotations once the
+// phase is done. The errors of all the steps are aggregated, a failing step does not
+// cancel the others.
+func (c *TargetConfigReconciler) runApplyPhase(ctx context.Context, phase string, specAnnotations map[string]string, steps ...applyStep) error {
+	start := time.Now()
+	defer func() {
+		metrics.ObserveApplyPhase(phase, time.Since(start))
+	}()
+
+	annotations := make([]map[string]string, len(steps))
+	errs := make([]error, len(steps))
+	var wg sync.WaitGroup
+	for i, step := range steps {
+		annotations[i] = map[string]string{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c.applySlots <- struct{}{}
+			defer func() { <-c.applySlots }()
+			errs[i] = step(ctx, annotations[i])
+		}()
+	}
+	wg.Wait()
+
+	for _, a := range annotations {
+		maps.Copy(specAnnotations, a)
+	}
+	return utilerror.NewAggregate(errs)
+}
+
+// instrumentedResourceCache serializes the access to a resource cache shared by the steps
+// of the apply phases, and records its hits and misses and the objects applied.
+type instrumentedResourceCache struct {
+	lock  sync.Mutex
+	cache resourceapply.ResourceCache
+}
+
+func newInstrumentedResourceCache(cache resourceapply.ResourceCache) *instrumentedResourceCache {
+	return &instrumentedResourceCache{cache: cache}
+}
+
+// UpdateCachedResourceMetadata is called once an object is applied.
+func (c *instrumentedResourceCache) UpdateCachedResourceMetadata(required runtime.Object, actual runtime.Object) {
+	metrics.RecordApply(kindOf(required))
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.cache.UpdateCachedResourceMetadata(required, actual)
+}
+
+func (c *instrumentedResourceCache) SafeToSkipApply(required runtime.Object, existing runtime.Object) bool {
+	c.lock.Lock()
+	skip := c.cache.SafeToSkipApply(required, existing)
+	c.lock.Unlock()
+	metrics.RecordResourceCacheLookup(kindOf(required), skip)
+	return skip
+}
+
+// kindOf returns the kind of an object, from its type when its TypeMeta is not set.
+func kindOf(obj runtime.Object) string {
+	if kind := obj.GetObjectKind().GroupVersionKind().Kind; kind != "" {
+		return kind
+	}
+	return reflect.Indirect(reflect.ValueOf(obj)).Type().Name()
+}
@@ -0,0 +1,239 @@
+package operator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	applyoperatorv1 "github.com/openshift/client-go/operator/applyconfigurations/operator/v1"
+	kueuev1 "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+	kueueconfigclient "github.com/openshift/kueue-operator/pkg/generated/clientset/versioned/typed/kueueoperator/v1"
+	"github.com/openshift/kueue-operator/pkg/limitrange"
+	"github.com/openshift/kueue-operator/pkg/operator/operatorclient"
+	"github.com/openshift/kueue-operator/pkg/queues"
+	"github.com/openshift/library-go/pkg/controller/factory"
+	"github.com/openshift/library-go/pkg/operator/events"
+	"github.com/openshift/library-go/pkg/operator/resource/resourcehelper"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilerror "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	corev1informers "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/kubernetes"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+)
+
+const (
+	limitRangesReadyConditionType = "LimitRangesReady"
+	containerDefaultsFieldManager = "kueue-operator-container-defaults"
+	// maxReportedConflicts bounds the number of namespaces listed in the condition.
+	maxReportedConflicts = 10
+)
+
+// ContainerDefaultsController creates the LimitRange setting the default requests and
+// limits of containers in the namespaces labeled with kueue.openshift.io/managed=true,
+// and deletes it when the label is removed.
+type ContainerDefaultsController struct {
+	operatorClient    kueueconfigclient.KueueV1Interface
+	kueueClient       *operatorclient.KueueClient
+	kubeClient        kubernetes.Interface
+	namespaceLister   corev1listers.NamespaceLister
+	limitRangeLister  corev1listers.LimitRangeLister
+	operatorNamespace string
+	eventRecorder     events.Recorder
+}
+
+func NewContainerDefaultsController(
+	operatorConfigClient kueueconfigclient.KueueV1Interface,
+	kueueClient *operatorclient.KueueClient,
+	kubeClient kubernetes.Interface,
+	namespaceInformer corev1informers.NamespaceInformer,
+	limitRangeInformer corev1informers.LimitRangeInformer,
+	operatorNamespace string,
+	eventRecorder events.Recorder,
+) factory.Controller {
+	c := &ContainerDefaultsController{
+		operatorClient:    operatorConfigClient,
+		kueueClient:       kueueClient,
+		kubeClient:        kubeClient,
+		namespaceLister:   namespaceInformer.Lister(),
+		limitRangeLister:  limitRangeInformer.Lister(),
+		operatorNamespace: operatorNamespace,
+		eventRecorder:     eventRecorder,
+	}
+
+	isManagedNamespace := func(obj interface{}) bool {
+		if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+		ns, ok := obj.(*corev1.Namespace)
+		return ok && queues.IsManagedNamespace(ns)
+	}
+
+	return factory.New().
+		WithInformers(kueueClient.Informer(), limitRangeInformer.Informer()).
+		WithFilteredEventsInformers(isManagedNamespace, namespaceInformer.Informer()).
+		ResyncEvery(5*time.Minute).
+		WithSync(c.sync).
+		ToController("ContainerDefaultsController", eventRecorder)
+}
+
+func (c *ContainerDefaultsController) sync(ctx context.Context, syncCtx factory.SyncContext) error {
+	obj, exists, err := c.kueueClient.Informer().GetIndexer().GetByKey(operatorclient.OperatorConfigName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+	kueue, ok := obj.(*kueuev1.Kueue)
+	if !ok {
+		klog.Errorf("unable to convert cached object to Kueue type")
+		return nil
+	}
+	if kueue.DeletionTimestamp != nil {
+		return nil
+	}
+
+	defaults := kueue.Spec.ContainerDefaults
+	namespaces := []*corev1.Namespace{}
+	if len(defaults.Resources) > 0 {
+		namespaces, err = listManagedNamespaces(c.namespaceLister, c.operatorNamespace)
+		if err != nil {
+			return fmt.Errorf("failed to list managed namespaces: %w", err)
+		}
+	}
+
+	var errorList []error
+	desired := sets.New[string]()
+	conflicts := []string{}
+	for _, ns := range namespaces {
+		limitRanges, err := c.limitRangeLister.LimitRanges(ns.Name).List(labels.Everything())
+		if err != nil {
+			return err
+		}
+		conflict := limitrange.Conflict(defaults, limitRanges)
+		for _, limitRange := range limitRanges {
+			if limitRange.Name == limitrange.Name && !limitrange.IsManaged(limitRange) {
+				conflict = fmt.Sprintf("LimitRange %s was not created by the operator", limitRange.Name)
+			}
+		}
+		if conflict != "" {
+			conflicts = append(conflicts, fmt.Sprintf("%s: %s", ns.Name, conflict))
+			continue
+		}
+		desired.Insert(ns.Name)
+		if err := c.applyLimitRange(ctx, limitrange.Build(defaults, ns.Name)); err != nil {
+			errorList = append(errorList, err)
+		}
+	}
+
+	// The LimitRanges of namespaces that are no longer managed, or that now conflict,
+	// are deleted.
+	selector, err := labels.Parse(limitrange.Selector())
+	if err != nil {
+		return err
+	}
+	managed, err := c.limitRangeLister.List(selector)
+	if err != nil {
+		return err
+	}
+	for _, limitRange := range managed {
+		if limitRange.Name != limitrange.Name || desired.Has(limitRange.Namespace) {
+			continue
+		}
+		err := c.kubeClient.CoreV1().LimitRanges(limitRange.Namespace).Delete(ctx, limitRange.Name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			errorList = append(errorList, fmt.Errorf("failed to delete LimitRange %s/%s: %w", limitRange.Namespace, limitRange.Name, err))
+			continue
+		}
+		resourcehelper.ReportDeleteEvent(c.eventRecorder, limitRange, err)
+	}
+
+	if len(defaults.Resources) == 0 {
+		// Removes the condition previously reported.
+		if err := applyStatusConditions(ctx, c.operatorClient, kueue, containerDefaultsFieldManager); err != nil {
+			return err
+		}
+		return utilerror.NewAggregate(errorList)
+	}
+
+	condition := applyoperatorv1.OperatorCondition().
+		WithType(limitRangesReadyConditionType).
+		WithStatus(operatorv1.ConditionTrue).
+		WithReason("AsExpected").
+		WithMessage(fmt.Sprintf("%d of %d managed namespaces have the default container requests and limits", desired.Len(), len(namespaces)))
+	switch {
+	case len(errorList) > 0:
+		condition = condition.
+			WithStatus(operatorv1.ConditionFalse).
+			WithReason("ApplyFailed").
+			WithMessage(utilerror.NewAggregate(errorList).Error())
+	case len(conflicts) > 0:
+		reported := conflicts
+		if len(reported) > maxReportedConflicts {
+			reported = append(reported[:maxReportedConflicts:maxReportedConflicts], fmt.Sprintf("and %d more", len(conflicts)-maxReportedConflicts))
+		}
+		condition = condition.
+			WithStatus(operatorv1.ConditionFalse).
+			WithReason("ConflictingLimitRanges").
+			WithMessage(fmt.Sprintf("%d managed namespaces have LimitRanges conflicting with the container defaults and are left unchanged: %s", len(conflicts), strings.Join(reported, "; ")))
+	}
+	if err := applyStatusConditions(ctx, c.operatorClient, kueue, containerDefaultsFieldManager, condition); err != nil {
+		return err
+	}
+	return utilerror.NewAggregate(errorList)
+}
+
+// applyLimitRange creates the LimitRange, or updates it when its spec differs.
+func (c *ContainerDefaultsController) applyLimitRange(ctx context.Context, want *corev1.LimitRange) error {
+	current, err := c.limitRangeLister.LimitRanges(want.Namespace).Get(want.Name)
+	if apierrors.IsNotFound(err) {
+		_, err := c.kubeClient.CoreV1().LimitRanges(want.Namespace).Create(ctx, want, metav1.CreateOptions{})
+		resourcehelper.ReportCreateEvent(c.eventRecorder, want, err)
+		if err != nil && !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("failed to create LimitRange %s/%s: %w", want.Namespace, want.Name, err)
+		}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if equality.Semantic.DeepEqual(current.Spec, want.Spec) {
+		return nil
+	}
+
+	updated := current.DeepCopy()
+	updated.Spec = want.Spec
+	_, err = c.kubeClient.CoreV1().LimitRanges(want.Namespace).Update(ctx, updated, metav1.UpdateOptions{})
+	resourcehelper.ReportUpdateEvent(c.eventRecorder, want, err)
+	if err != nil {
+		return fmt.Errorf("failed to update LimitRange %s/%s: %w", want.Namespace, want.Name, err)
+	}
+	return nil
+}
+
+// cleanUpLimitRanges deletes the LimitRanges created by the ContainerDefaultsController
+// when Kueue is uninstalled.
+func (c *TargetConfigReconciler) cleanUpLimitRanges(ctx context.Context) error {
+	limitRanges, err := c.kubeClient.CoreV1().LimitRanges(metav1.NamespaceAll).List(ctx, metav1.ListOptions{LabelSelector: limitrange.Selector()})
+	if err != nil {
+		return fmt.Errorf("failed to list LimitRanges: %w", err)
+	}
+	var errorList []error
+	for _, limitRange := range limitRanges.Items {
+		klog.Infof("Deleting LimitRange: %s/%s", limitRange.Namespace, limitRange.Name)
+		err := c.kubeClient.CoreV1().LimitRanges(limitRange.Namespace).Delete(ctx, limitRange.Name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			errorList = append(errorList, fmt.Errorf("failed to delete LimitRange %s/%s: %w", limitRange.Namespace, limitRange.Name, err))
+		}
+	}
+	return utilerror.NewAggregate(errorList)
+}
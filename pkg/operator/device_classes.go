@@ -0,0 +1,123 @@
+package operator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	applyoperatorv1 "github.com/openshift/client-go/operator/applyconfigurations/operator/v1"
+	kueuev1 "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+	"github.com/openshift/kueue-operator/pkg/dra"
+	applyconfigurationkueueoperatorv1 "github.com/openshift/kueue-operator/pkg/generated/applyconfiguration/kueueoperator/v1"
+	"github.com/openshift/library-go/pkg/controller/factory"
+	"github.com/openshift/library-go/pkg/operator/v1helpers"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+)
+
+const deviceClassesAvailableConditionType = "DeviceClassesAvailable"
+
+// deviceClassGVK is the DRA API Kueue's DRA integration requires, resource.k8s.io/v1
+// (Kubernetes 1.34+ / OCP 4.21+).
+var deviceClassGVK = schema.GroupVersionKind{
+	Group:   "resource.k8s.io",
+	Version: "v1",
+	Kind:    "DeviceClass",
+}
+
+// refreshDRASupport checks whether the DRA APIs became served since the operator
+// started, after a cluster upgrade. The DeviceClass informer is then started, and its
+// events queue full syncs like the ones of the informers registered at startup.
+func (c *TargetConfigReconciler) refreshDRASupport(ctx context.Context, queue workqueue.RateLimitingInterface) error {
+	if c.draSupported {
+		return nil
+	}
+	served, err := isResourceRegistered(c.discoveryClient, deviceClassGVK)
+	if err != nil || !served {
+		return err
+	}
+
+	klog.Infof("DRA APIs (resource.k8s.io/v1) became available, watching DeviceClasses")
+	informer := c.kubeInformer.Resource().V1().DeviceClasses()
+	queueSync := func(interface{}) { queue.Add(factory.DefaultQueueKey) }
+	if _, err := informer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    queueSync,
+		UpdateFunc: func(_, obj interface{}) { queueSync(obj) },
+		DeleteFunc: queueSync,
+	}); err != nil {
+		return fmt.Errorf("failed to watch DeviceClasses: %w", err)
+	}
+	c.kubeInformer.Start(ctx.Done())
+	c.deviceClassInformer = informer
+	c.draSupported = true
+	return nil
+}
+
+// manageDeviceClassMappings checks the DeviceClasses referenced by the configured mappings
+// and discovers mappings for the remaining DeviceClasses when auto discovery is enabled.
+// It returns the mappings Kueue is configured with and a DeviceClassesAvailable condition,
+// which is nil when DeviceClasses cannot be checked yet.
+func (c *TargetConfigReconciler) manageDeviceClassMappings(kueue *kueuev1.Kueue) ([]kueuev1.DeviceClassMapping, *applyoperatorv1.OperatorConditionApplyConfiguration, error) {
+	resources := kueue.Spec.Config.Resources
+	c.discoveredDeviceClassMappings = nil
+
+	informer := c.deviceClassInformer
+	if informer == nil || !informer.Informer().HasSynced() {
+		return resources.DeviceClassMappings, nil, nil
+	}
+
+	deviceClasses, err := informer.Lister().List(labels.Everything())
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list DeviceClasses: %w", err)
+	}
+
+	mappings := resources.DeviceClassMappings
+	switch resources.AutoDiscover.Mode {
+	case kueuev1.DeviceClassAutoDiscoverModePropose:
+		c.discoveredDeviceClassMappings = dra.DiscoverMappings(deviceClasses, resources.DeviceClassMappings)
+	case kueuev1.DeviceClassAutoDiscoverModeGenerate:
+		c.discoveredDeviceClassMappings = dra.DiscoverMappings(deviceClasses, resources.DeviceClassMappings)
+		mappings = dra.MergeMappings(resources.DeviceClassMappings, c.discoveredDeviceClassMappings)
+	}
+
+	if len(resources.DeviceClassMappings) == 0 {
+		return mappings, nil, nil
+	}
+	missing := dra.MissingDeviceClasses(resources.DeviceClassMappings, func(name string) bool {
+		_, err := informer.Lister().Get(name)
+		return !errors.IsNotFound(err)
+	})
+	if len(missing) == 0 {
+		return mappings, applyoperatorv1.OperatorCondition().
+			WithType(deviceClassesAvailableConditionType).
+			WithStatus(operatorv1.ConditionTrue).
+			WithReason("AsExpected").
+			WithMessage("All DeviceClasses referenced by deviceClassMappings exist"), nil
+	}
+
+	message := fmt.Sprintf("deviceClassMappings reference DeviceClasses that do not exist: %s", strings.Join(missing, ", "))
+	if previous := v1helpers.FindOperatorCondition(kueue.Status.Conditions, deviceClassesAvailableConditionType); previous == nil || previous.Message != message {
+		klog.Warning(message)
+		c.eventRecorder.Warningf("DeviceClassesMissing", "%s", message)
+	}
+	return mappings, applyoperatorv1.OperatorCondition().
+		WithType(deviceClassesAvailableConditionType).
+		WithStatus(operatorv1.ConditionFalse).
+		WithReason("MissingDeviceClasses").
+		WithMessage(message), nil
+}
+
+func deviceClassMappingApplyConfigurations(mappings []kueuev1.DeviceClassMapping) []*applyconfigurationkueueoperatorv1.DeviceClassMappingApplyConfiguration {
+	configurations := make([]*applyconfigurationkueueoperatorv1.DeviceClassMappingApplyConfiguration, 0, len(mappings))
+	for _, mapping := range mappings {
+		configurations = append(configurations, applyconfigurationkueueoperatorv1.DeviceClassMapping().
+			WithName(mapping.Name).
+			WithDeviceClassNames(mapping.DeviceClassNames...))
+	}
+	return configurations
+}
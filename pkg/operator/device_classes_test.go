@@ -0,0 +1,59 @@
+package operator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openshift/library-go/pkg/controller/factory"
+	resourcev1 "k8s.io/api/resource/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/util/workqueue"
+)
+
+func TestRefreshDRASupportWhenTheAPIAppears(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	kubeClient := fake.NewClientset()
+	c := &TargetConfigReconciler{
+		discoveryClient: kubeClient.Discovery(),
+		kubeInformer:    informers.NewSharedInformerFactory(kubeClient, 0),
+	}
+	queue := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+	defer queue.ShutDown()
+
+	if err := c.refreshDRASupport(ctx, queue); err != nil {
+		t.Fatalf("refreshDRASupport() failed: %v", err)
+	}
+	if c.draSupported || c.deviceClassInformer != nil {
+		t.Fatalf("DRA is supported before the API is served")
+	}
+
+	// The cluster is upgraded to serve the DRA APIs between two syncs.
+	kubeClient.Resources = []*metav1.APIResourceList{{
+		GroupVersion: deviceClassGVK.GroupVersion().String(),
+		APIResources: []metav1.APIResource{{Name: "deviceclasses", Kind: deviceClassGVK.Kind}},
+	}}
+	if err := c.refreshDRASupport(ctx, queue); err != nil {
+		t.Fatalf("refreshDRASupport() failed: %v", err)
+	}
+	if !c.draSupported || c.deviceClassInformer == nil {
+		t.Fatalf("DRA is not supported once the API is served")
+	}
+
+	if _, err := kubeClient.ResourceV1().DeviceClasses().Create(ctx, &resourcev1.DeviceClass{ObjectMeta: metav1.ObjectMeta{Name: "gpu.example.com"}}, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("failed to create the DeviceClass: %v", err)
+	}
+	if err := wait.PollUntilContextTimeout(ctx, 10*time.Millisecond, wait.ForeverTestTimeout, true, func(context.Context) (bool, error) {
+		_, err := c.deviceClassInformer.Lister().Get("gpu.example.com")
+		return err == nil, nil
+	}); err != nil {
+		t.Fatalf("The DeviceClass informer is not started: %v", err)
+	}
+	if key, _ := queue.Get(); key != factory.DefaultQueueKey {
+		t.Errorf("Unexpected queue key %v, want %v", key, factory.DefaultQueueKey)
+	}
+}
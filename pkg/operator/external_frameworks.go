@@ -0,0 +1,115 @@
+package operator
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	applyoperatorv1 "github.com/openshift/client-go/operator/applyconfigurations/operator/v1"
+	kueuev1 "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+	"github.com/openshift/kueue-operator/pkg/rbac"
+	"github.com/openshift/library-go/pkg/operator/v1helpers"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+const externalFrameworksAvailableConditionType = "ExternalFrameworksAvailable"
+
+// manageExternalFrameworksRBAC grants the Kueue controller access to every external
+// framework listed in the integrations or MultiKueue configuration.
+// The ClusterRole is rebuilt from the configuration on each sync, so rules for frameworks
+// that were dropped are removed; when no external frameworks remain the ClusterRole
+// and its binding are deleted.
+func (c *TargetConfigReconciler) manageExternalFrameworksRBAC(ctx context.Context, kueue *kueuev1.Kueue, specAnnotations map[string]string, ownerReference metav1.OwnerReference) error {
+	frameworks := rbac.MergeExternalFrameworks(kueue.Spec.Config)
+
+	required := rbac.BuildExternalFrameworksClusterRole(frameworks)
+	if required == nil {
+		return c.deleteExternalFrameworksRBAC(ctx)
+	}
+	required.OwnerReferences = []metav1.OwnerReference{
+		ownerReference,
+	}
+	clusterRole, _, err := c.applyClusterRoleWithCache(ctx, required)
+	if err != nil {
+		return err
+	}
+	hash, err := computeSpecHash(clusterRole.Rules)
+	if err != nil {
+		return fmt.Errorf("failed to hash ClusterRole rules: %w", err)
+	}
+	specAnnotations["clusterrole/"+clusterRole.Name] = hash
+
+	requiredBinding := rbac.BuildExternalFrameworksClusterRoleBinding(c.operatorNamespace)
+	requiredBinding.OwnerReferences = []metav1.OwnerReference{
+		ownerReference,
+	}
+	binding, _, err := c.applyClusterRoleBindingWithCache(ctx, requiredBinding)
+	if err != nil {
+		return err
+	}
+	hash, err = computeSpecHash([]interface{}{binding.Subjects, binding.RoleRef})
+	if err != nil {
+		return fmt.Errorf("failed to hash ClusterRoleBinding: %w", err)
+	}
+	specAnnotations["clusterrolebinding/"+binding.Name] = hash
+	return nil
+}
+
+// deleteExternalFrameworksRBAC removes the external frameworks ClusterRole and binding
+// if they exist.
+func (c *TargetConfigReconciler) deleteExternalFrameworksRBAC(ctx context.Context) error {
+	if err := c.deleteClusterRoleBindingIfExists(ctx, rbac.ExternalFrameworksClusterRoleBindingName); err != nil {
+		return err
+	}
+	return c.deleteClusterRoleIfExists(ctx, rbac.ExternalFrameworksClusterRoleName)
+}
+
+// findUnknownExternalFrameworks returns the external frameworks whose resource is
+// not served by the API server, formatted as resource.version.group. The frameworks are
+// looked up in the CRD informer cache, a framework is known when its CRD serves the
+// configured version.
+func (c *TargetConfigReconciler) findUnknownExternalFrameworks(frameworks []kueuev1.ExternalFramework) []string {
+	unknown := []string{}
+	for _, fw := range frameworks {
+		name := fmt.Sprintf("%s.%s.%s", fw.Resource, fw.Version, fw.Group)
+		crd, err := c.getCustomResourceDefinitionCached(fw.Resource + "." + fw.Group)
+		if err != nil {
+			klog.V(4).Infof("failed to get CRD %s.%s from cache: %v", fw.Resource, fw.Group, err)
+		}
+		if crd == nil || !slices.ContainsFunc(crd.Spec.Versions, func(v apiextensionsv1.CustomResourceDefinitionVersion) bool {
+			return v.Name == fw.Version && v.Served
+		}) {
+			unknown = append(unknown, name)
+		}
+	}
+	return unknown
+}
+
+// buildExternalFrameworksCondition reports whether every configured external framework
+// is served by the API server. The unknown frameworks are reported by an event when the
+// condition becomes False or when they change, not on every sync.
+func (c *TargetConfigReconciler) buildExternalFrameworksCondition(kueue *kueuev1.Kueue) *applyoperatorv1.OperatorConditionApplyConfiguration {
+	frameworks := rbac.MergeExternalFrameworks(kueue.Spec.Config)
+	unknown := c.findUnknownExternalFrameworks(frameworks)
+	if len(unknown) > 0 {
+		message := fmt.Sprintf("The following external frameworks are not served by the API server: %s", strings.Join(unknown, ", "))
+		if previous := v1helpers.FindOperatorCondition(kueue.Status.Conditions, externalFrameworksAvailableConditionType); previous == nil || previous.Status != operatorv1.ConditionFalse || previous.Message != message {
+			klog.Warningf("External frameworks not found on the cluster: %s", strings.Join(unknown, ", "))
+			c.eventRecorder.Warningf("ExternalFrameworksUnknown", "external frameworks not found on the cluster: %s", strings.Join(unknown, ", "))
+		}
+		return applyoperatorv1.OperatorCondition().
+			WithType(externalFrameworksAvailableConditionType).
+			WithStatus(operatorv1.ConditionFalse).
+			WithReason("UnknownResources").
+			WithMessage(message)
+	}
+	return applyoperatorv1.OperatorCondition().
+		WithType(externalFrameworksAvailableConditionType).
+		WithStatus(operatorv1.ConditionTrue).
+		WithReason("AsExpected").
+		WithMessage(fmt.Sprintf("%d external frameworks found", len(frameworks)))
+}
@@ -0,0 +1,81 @@
+package operator
+
+import (
+	"testing"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	kueuev1 "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+	"github.com/openshift/library-go/pkg/operator/events"
+	apiextfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	apiextinformer "k8s.io/apiextensions-apiserver/pkg/client/informers/externalversions"
+	"k8s.io/utils/clock"
+)
+
+func TestBuildExternalFrameworksConditionEvents(t *testing.T) {
+	const unknownMessage = "The following external frameworks are not served by the API server: pipelineruns.v1.tekton.dev"
+	pipelineRuns := kueuev1.ExternalFramework{Group: "tekton.dev", Resource: "pipelineruns", Version: "v1"}
+	testCases := map[string]struct {
+		frameworks []kueuev1.ExternalFramework
+		previous   []operatorv1.OperatorCondition
+		wantStatus operatorv1.ConditionStatus
+		wantEvents int
+	}{
+		"no external framework": {
+			wantStatus: operatorv1.ConditionTrue,
+		},
+		"unknown framework without a previous condition": {
+			frameworks: []kueuev1.ExternalFramework{pipelineRuns},
+			wantStatus: operatorv1.ConditionFalse,
+			wantEvents: 1,
+		},
+		"unknown framework while the condition was True": {
+			frameworks: []kueuev1.ExternalFramework{pipelineRuns},
+			previous: []operatorv1.OperatorCondition{{
+				Type:    externalFrameworksAvailableConditionType,
+				Status:  operatorv1.ConditionTrue,
+				Message: "0 external frameworks found",
+			}},
+			wantStatus: operatorv1.ConditionFalse,
+			wantEvents: 1,
+		},
+		"same unknown framework as the previous sync": {
+			frameworks: []kueuev1.ExternalFramework{pipelineRuns},
+			previous: []operatorv1.OperatorCondition{{
+				Type:    externalFrameworksAvailableConditionType,
+				Status:  operatorv1.ConditionFalse,
+				Message: unknownMessage,
+			}},
+			wantStatus: operatorv1.ConditionFalse,
+		},
+		"unknown frameworks changed": {
+			frameworks: []kueuev1.ExternalFramework{pipelineRuns, {Group: "tekton.dev", Resource: "taskruns", Version: "v1"}},
+			previous: []operatorv1.OperatorCondition{{
+				Type:    externalFrameworksAvailableConditionType,
+				Status:  operatorv1.ConditionFalse,
+				Message: unknownMessage,
+			}},
+			wantStatus: operatorv1.ConditionFalse,
+			wantEvents: 1,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			recorder := events.NewInMemoryRecorder("test", clock.RealClock{})
+			c := &TargetConfigReconciler{
+				crdInformer:   apiextinformer.NewSharedInformerFactory(apiextfake.NewClientset(), 0),
+				eventRecorder: recorder,
+			}
+			kueue := &kueuev1.Kueue{}
+			kueue.Spec.Config.Integrations.ExternalFrameworks = tc.frameworks
+			kueue.Status.Conditions = tc.previous
+
+			condition := c.buildExternalFrameworksCondition(kueue)
+			if *condition.Status != tc.wantStatus {
+				t.Errorf("Unexpected status: got %s, want %s", *condition.Status, tc.wantStatus)
+			}
+			if got := len(recorder.Events()); got != tc.wantEvents {
+				t.Errorf("Unexpected events: got %d, want %d: %v", got, tc.wantEvents, recorder.Events())
+			}
+		})
+	}
+}
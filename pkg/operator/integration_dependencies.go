@@ -0,0 +1,71 @@
+package operator
+
+import (
+	kueuev1 "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+	applyconfigurationkueueoperatorv1 "github.com/openshift/kueue-operator/pkg/generated/applyconfiguration/kueueoperator/v1"
+	"github.com/openshift/kueue-operator/pkg/integration"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/klog/v2"
+)
+
+// checkIntegrationDependencies checks that the CRD required by every enabled integration
+// is installed and serves the version Kueue uses. Built-in integrations are always available.
+func (c *TargetConfigReconciler) checkIntegrationDependencies(frameworks []kueuev1.KueueIntegration) ([]kueuev1.IntegrationStatus, error) {
+	statuses := make([]kueuev1.IntegrationStatus, 0, len(frameworks))
+	for _, framework := range frameworks {
+		var crd *apiextensionsv1.CustomResourceDefinition
+		if api, ok := integration.APIFor(framework); ok && !api.BuiltIn {
+			var err error
+			crd, err = c.getCustomResourceDefinitionCached(api.CRDName())
+			if err != nil {
+				return nil, err
+			}
+		}
+		statuses = append(statuses, integration.CheckCRD(framework, crd))
+	}
+	return statuses, nil
+}
+
+// recordIntegrationStatusChanges emits an event for every integration whose state differs
+// from the state last reported in the Kueue status, so that a missing CRD is reported once
+// rather than on every sync.
+func (c *TargetConfigReconciler) recordIntegrationStatusChanges(previous, current []kueuev1.IntegrationStatus) {
+	previousStates := make(map[kueuev1.KueueIntegration]kueuev1.IntegrationState, len(previous))
+	for _, status := range previous {
+		previousStates[status.Name] = status.State
+	}
+	for _, status := range current {
+		previousState, found := previousStates[status.Name]
+		if found && previousState == status.State {
+			continue
+		}
+		if status.State == kueuev1.IntegrationStateAvailable {
+			if found {
+				c.eventRecorder.Eventf("IntegrationAvailable", "%s: %s", status.Name, status.Message)
+			}
+			continue
+		}
+		klog.Warningf("Integration %s is %s: %s", status.Name, status.State, status.Message)
+		c.eventRecorder.Warningf("IntegrationUnavailable", "%s is %s: %s", status.Name, status.State, status.Message)
+	}
+}
+
+func integrationStatusApplyConfigurations(statuses []kueuev1.IntegrationStatus) []*applyconfigurationkueueoperatorv1.IntegrationStatusApplyConfiguration {
+	configurations := make([]*applyconfigurationkueueoperatorv1.IntegrationStatusApplyConfiguration, 0, len(statuses))
+	for _, status := range statuses {
+		configuration := applyconfigurationkueueoperatorv1.IntegrationStatus().
+			WithName(status.Name).
+			WithState(status.State)
+		if status.Message != "" {
+			configuration.WithMessage(status.Message)
+		}
+		if len(status.ServedVersions) > 0 {
+			configuration.WithServedVersions(status.ServedVersions...)
+		}
+		if status.RequiredVersion != "" {
+			configuration.WithRequiredVersion(status.RequiredVersion)
+		}
+		configurations = append(configurations, configuration)
+	}
+	return configurations
+}
@@ -0,0 +1,363 @@
+package operator
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	applyoperatorv1 "github.com/openshift/client-go/operator/applyconfigurations/operator/v1"
+	kueuev1 "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+	"github.com/openshift/kueue-operator/pkg/configmap"
+	kueueconfigclient "github.com/openshift/kueue-operator/pkg/generated/clientset/versioned/typed/kueueoperator/v1"
+	"github.com/openshift/kueue-operator/pkg/integration"
+	"github.com/openshift/kueue-operator/pkg/operator/operatorclient"
+	"github.com/openshift/kueue-operator/pkg/uninstall"
+	"github.com/openshift/library-go/pkg/controller/factory"
+	"github.com/openshift/library-go/pkg/operator/events"
+	"github.com/openshift/library-go/pkg/operator/v1helpers"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	utilerror "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	appsv1listers "k8s.io/client-go/listers/apps/v1"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// removedIntegrationsConfigMap records the integrations removed from the Kueue
+	// configuration whose workloads remain, keyed by integration, with the time they
+	// were removed.
+	removedIntegrationsConfigMap = "kueue-removed-integrations"
+
+	integrationRemovalProgressingConditionType = "IntegrationRemovalProgressing"
+	integrationRemovalFieldManager             = "kueue-operator-integration-removal"
+)
+
+// recordRemovedIntegrations compares the frameworks of the Kueue configuration about to
+// be applied with the previous one. The removed frameworks are recorded, and the ones
+// enabled again are forgotten. Only the suspendable frameworks are recorded: the
+// Workloads of the frameworks gated through pods are owned by the pods.
+func (c *TargetConfigReconciler) recordRemovedIntegrations(ctx context.Context, previous, current *corev1.ConfigMap) error {
+	previousFrameworks, err := configmap.Frameworks(previous)
+	if err != nil {
+		// The previous configuration was not built by the operator, nothing can be
+		// compared with it.
+		klog.Warningf("Unable to compare the Kueue configuration with the previous one: %v", err)
+		return nil
+	}
+	currentFrameworks, err := configmap.Frameworks(current)
+	if err != nil {
+		return err
+	}
+
+	record, err := c.kubeClient.CoreV1().ConfigMaps(c.operatorNamespace).Get(ctx, removedIntegrationsConfigMap, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		record = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: removedIntegrationsConfigMap, Namespace: c.operatorNamespace}}
+	} else if err != nil {
+		return fmt.Errorf("failed to get ConfigMap %s/%s: %w", c.operatorNamespace, removedIntegrationsConfigMap, err)
+	}
+
+	data := map[string]string{}
+	for framework, removed := range record.Data {
+		if !slices.Contains(currentFrameworks, kueuev1.KueueIntegration(framework)) {
+			data[framework] = removed
+		}
+	}
+	for _, framework := range previousFrameworks {
+		api, ok := integration.APIFor(framework)
+		if !ok || len(api.SuspendPath) == 0 || slices.Contains(currentFrameworks, framework) {
+			continue
+		}
+		if _, found := data[string(framework)]; !found {
+			klog.Infof("Integration %s is removed from the Kueue configuration", framework)
+			data[string(framework)] = time.Now().UTC().Format(time.RFC3339)
+		}
+	}
+	if maps.Equal(record.Data, data) {
+		return nil
+	}
+
+	record.Data = data
+	if record.ResourceVersion == "" {
+		_, err = c.kubeClient.CoreV1().ConfigMaps(c.operatorNamespace).Create(ctx, record, metav1.CreateOptions{})
+	} else {
+		_, err = c.kubeClient.CoreV1().ConfigMaps(c.operatorNamespace).Update(ctx, record, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to record the integrations removed from the Kueue configuration: %w", err)
+	}
+	return nil
+}
+
+// IntegrationRemovalController handles the workloads of the integrations removed from
+// the Kueue configuration, according to the removed frameworks policy, and reports them
+// in the IntegrationRemovalProgressing condition. An integration is forgotten once none
+// of its Workloads remain.
+type IntegrationRemovalController struct {
+	operatorClient    kueueconfigclient.KueueV1Interface
+	kueueClient       *operatorclient.KueueClient
+	kubeClient        kubernetes.Interface
+	dynamicClient     dynamic.Interface
+	configMapLister   corev1listers.ConfigMapLister
+	deploymentLister  appsv1listers.DeploymentLister
+	operatorNamespace string
+	eventRecorder     events.Recorder
+}
+
+func NewIntegrationRemovalController(
+	operatorConfigClient kueueconfigclient.KueueV1Interface,
+	kueueClient *operatorclient.KueueClient,
+	kubeClient kubernetes.Interface,
+	dynamicClient dynamic.Interface,
+	kubeInformersForNamespaces v1helpers.KubeInformersForNamespaces,
+	operatorNamespace string,
+	eventRecorder events.Recorder,
+) factory.Controller {
+	informers := kubeInformersForNamespaces.InformersFor(operatorNamespace)
+	c := &IntegrationRemovalController{
+		operatorClient:    operatorConfigClient,
+		kueueClient:       kueueClient,
+		kubeClient:        kubeClient,
+		dynamicClient:     dynamicClient,
+		configMapLister:   informers.Core().V1().ConfigMaps().Lister(),
+		deploymentLister:  informers.Apps().V1().Deployments().Lister(),
+		operatorNamespace: operatorNamespace,
+		eventRecorder:     eventRecorder,
+	}
+
+	// The Workloads are listed on every resync rather than watched.
+	return factory.New().
+		WithInformers(
+			kueueClient.Informer(),
+			informers.Core().V1().ConfigMaps().Informer(),
+			informers.Apps().V1().Deployments().Informer(),
+		).
+		ResyncEvery(time.Minute).
+		WithSync(c.sync).
+		ToController("IntegrationRemovalController", eventRecorder)
+}
+
+func (c *IntegrationRemovalController) sync(ctx context.Context, syncCtx factory.SyncContext) error {
+	obj, exists, err := c.kueueClient.Informer().GetIndexer().GetByKey(operatorclient.OperatorConfigName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+	kueue, ok := obj.(*kueuev1.Kueue)
+	if !ok {
+		klog.Errorf("unable to convert cached object to Kueue type")
+		return nil
+	}
+	if kueue.DeletionTimestamp != nil {
+		return nil
+	}
+
+	record, err := c.configMapLister.ConfigMaps(c.operatorNamespace).Get(removedIntegrationsConfigMap)
+	if apierrors.IsNotFound(err) || (err == nil && len(record.Data) == 0) {
+		// Removes the condition previously reported.
+		return applyStatusConditions(ctx, c.operatorClient, kueue, integrationRemovalFieldManager)
+	}
+	if err != nil {
+		return err
+	}
+
+	workloads, err := c.dynamicClient.Resource(uninstall.WorkloadsGVR).List(ctx, metav1.ListOptions{})
+	if err != nil && !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+		return fmt.Errorf("failed to list Workloads: %w", err)
+	}
+	remaining := map[kueuev1.KueueIntegration]int{}
+	suspended := map[kueuev1.KueueIntegration]int{}
+	owners := map[kueuev1.KueueIntegration][]integration.Owner{}
+	if err == nil {
+		frameworks := []kueuev1.KueueIntegration{}
+		for framework := range record.Data {
+			frameworks = append(frameworks, kueuev1.KueueIntegration(framework))
+		}
+		for _, workload := range workloads.Items {
+			for _, framework := range frameworks {
+				owner, ok := integration.OwnerOf(workload, []kueuev1.KueueIntegration{framework})
+				if !ok {
+					continue
+				}
+				remaining[framework]++
+				if uninstall.IsPending(workload) {
+					suspended[framework]++
+					owners[framework] = append(owners[framework], owner)
+				}
+			}
+		}
+	}
+
+	release := kueue.Spec.Config.Integrations.RemovedFrameworksPolicy == kueuev1.RemovedFrameworksPolicyRelease
+	var errorList []error
+	if release && len(remaining) > 0 {
+		// Kueue still running with the removed frameworks would suspend the released
+		// jobs again, and recreate their Workloads.
+		restarted, err := c.kueueRestarted()
+		if err != nil {
+			return err
+		}
+		if !restarted {
+			return applyStatusConditions(ctx, c.operatorClient, kueue, integrationRemovalFieldManager, applyoperatorv1.OperatorCondition().
+				WithType(integrationRemovalProgressingConditionType).
+				WithStatus(operatorv1.ConditionTrue).
+				WithReason("WaitingForKueue").
+				WithMessage(fmt.Sprintf("The workloads of the removed integrations %s are released once the Kueue deployment runs without them", describeRemovals(record.Data, remaining, suspended))))
+		}
+		errorList = c.releaseRemovedIntegrations(ctx, workloads.Items, record.Data, owners)
+	}
+
+	// The integrations without Workloads are forgotten. When released, the Workloads
+	// are counted again on the next resync.
+	data := map[string]string{}
+	for framework, removed := range record.Data {
+		if remaining[kueuev1.KueueIntegration(framework)] > 0 {
+			data[framework] = removed
+			continue
+		}
+		klog.Infof("No Workload of the removed integration %s remains", framework)
+	}
+	if !maps.Equal(record.Data, data) {
+		updated := record.DeepCopy()
+		updated.Data = data
+		if _, err := c.kubeClient.CoreV1().ConfigMaps(c.operatorNamespace).Update(ctx, updated, metav1.UpdateOptions{}); err != nil {
+			errorList = append(errorList, fmt.Errorf("failed to update ConfigMap %s/%s: %w", c.operatorNamespace, removedIntegrationsConfigMap, err))
+		}
+	}
+	if len(data) == 0 {
+		c.eventRecorder.Eventf("IntegrationRemovalComplete", "No Workload of the removed integrations remains")
+		if err := applyStatusConditions(ctx, c.operatorClient, kueue, integrationRemovalFieldManager); err != nil {
+			return err
+		}
+		return utilerror.NewAggregate(errorList)
+	}
+
+	condition := applyoperatorv1.OperatorCondition().
+		WithType(integrationRemovalProgressingConditionType).
+		WithStatus(operatorv1.ConditionTrue).
+		WithReason("WorkloadsRemaining").
+		WithMessage(fmt.Sprintf("Kueue does not manage the workloads of the removed integrations %s anymore: the suspended jobs stay suspended and the Workloads keep holding quota until they are deleted, or released with the Release removedFrameworksPolicy", describeRemovals(data, remaining, suspended)))
+	if release {
+		condition = condition.
+			WithReason("ReleasingWorkloads").
+			WithMessage(fmt.Sprintf("Releasing the workloads of the removed integrations %s", describeRemovals(data, remaining, suspended)))
+	}
+	if len(errorList) > 0 {
+		condition = condition.
+			WithReason("ReleaseFailed").
+			WithMessage(utilerror.NewAggregate(errorList).Error())
+	}
+	if err := applyStatusConditions(ctx, c.operatorClient, kueue, integrationRemovalFieldManager, condition); err != nil {
+		return err
+	}
+	return utilerror.NewAggregate(errorList)
+}
+
+// kueueRestarted reports whether all the pods of the Kueue deployment run with the
+// current Kueue configuration.
+func (c *IntegrationRemovalController) kueueRestarted() (bool, error) {
+	cfgMap, err := c.configMapLister.ConfigMaps(c.operatorNamespace).Get(KueueConfigMap)
+	if err != nil {
+		return false, err
+	}
+	hash, err := computeSpecHash(cfgMap.Data)
+	if err != nil {
+		return false, err
+	}
+	deployment, err := c.deploymentLister.Deployments(c.operatorNamespace).Get(operatorclient.OperandName)
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return deployment.Spec.Template.Annotations["configmap/"+KueueConfigMap] == hash && rolledOut(deployment), nil
+}
+
+// rolledOut reports whether all the replicas of the deployment run its current spec.
+func rolledOut(deployment *appsv1.Deployment) bool {
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+	status := deployment.Status
+	return status.ObservedGeneration >= deployment.Generation &&
+		status.UpdatedReplicas == replicas &&
+		status.Replicas == replicas &&
+		status.AvailableReplicas == replicas
+}
+
+// releaseRemovedIntegrations unsuspends the jobs of the removed integrations waiting
+// for admission, then deletes the Workloads of the removed integrations, removing their
+// finalizers.
+func (c *IntegrationRemovalController) releaseRemovedIntegrations(ctx context.Context, workloads []unstructured.Unstructured, removed map[string]string, owners map[kueuev1.KueueIntegration][]integration.Owner) []error {
+	var errorList []error
+	for _, framework := range owners {
+		for _, owner := range framework {
+			patch, err := integration.UnsuspendPatch(owner.API)
+			if err != nil {
+				errorList = append(errorList, err)
+				continue
+			}
+			_, err = c.dynamicClient.Resource(owner.API.GroupVersionResource()).Namespace(owner.Namespace).Patch(ctx, owner.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+			if err != nil && !apierrors.IsNotFound(err) {
+				errorList = append(errorList, fmt.Errorf("failed to unsuspend %s: %w", owner, err))
+				continue
+			}
+			klog.V(2).Infof("Unsuspended %s", owner)
+		}
+	}
+	if len(errorList) > 0 {
+		// The Workloads are kept, so that the jobs are unsuspended on the next resync.
+		return errorList
+	}
+
+	frameworks := []kueuev1.KueueIntegration{}
+	for framework := range removed {
+		frameworks = append(frameworks, kueuev1.KueueIntegration(framework))
+	}
+	for _, workload := range workloads {
+		if _, ok := integration.OwnerOf(workload, frameworks); !ok {
+			continue
+		}
+		client := c.dynamicClient.Resource(uninstall.WorkloadsGVR).Namespace(workload.GetNamespace())
+		if len(workload.GetFinalizers()) > 0 {
+			_, err := client.Patch(ctx, workload.GetName(), types.MergePatchType, []byte(`{"metadata":{"finalizers":null}}`), metav1.PatchOptions{})
+			if err != nil && !apierrors.IsNotFound(err) {
+				errorList = append(errorList, fmt.Errorf("failed to remove the finalizers of Workload %s/%s: %w", workload.GetNamespace(), workload.GetName(), err))
+				continue
+			}
+		}
+		if err := client.Delete(ctx, workload.GetName(), metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			errorList = append(errorList, fmt.Errorf("failed to delete Workload %s/%s: %w", workload.GetNamespace(), workload.GetName(), err))
+			continue
+		}
+		klog.V(2).Infof("Deleted Workload %s/%s of a removed integration", workload.GetNamespace(), workload.GetName())
+	}
+	return errorList
+}
+
+// describeRemovals lists the removed integrations with their remaining Workloads.
+func describeRemovals(removed map[string]string, remaining, suspended map[kueuev1.KueueIntegration]int) string {
+	frameworks := sets.List(sets.KeySet(removed))
+	parts := make([]string, 0, len(frameworks))
+	for _, framework := range frameworks {
+		integration := kueuev1.KueueIntegration(framework)
+		parts = append(parts, fmt.Sprintf("%s (%d Workloads, %d suspended jobs)", framework, remaining[integration], suspended[integration]))
+	}
+	return strings.Join(parts, ", ")
+}
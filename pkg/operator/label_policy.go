@@ -0,0 +1,241 @@
+package operator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	applyoperatorv1 "github.com/openshift/client-go/operator/applyconfigurations/operator/v1"
+	kueuev1 "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+	"github.com/openshift/kueue-operator/pkg/configmap"
+	applyconfigurationkueueoperatorv1 "github.com/openshift/kueue-operator/pkg/generated/applyconfiguration/kueueoperator/v1"
+	kueueconfigclient "github.com/openshift/kueue-operator/pkg/generated/clientset/versioned/typed/kueueoperator/v1"
+	"github.com/openshift/kueue-operator/pkg/integration"
+	"github.com/openshift/kueue-operator/pkg/labelpolicy"
+	"github.com/openshift/kueue-operator/pkg/operator/operatorclient"
+	"github.com/openshift/kueue-operator/pkg/queueassignment"
+	"github.com/openshift/kueue-operator/pkg/queues"
+	"github.com/openshift/library-go/pkg/controller/factory"
+	"github.com/openshift/library-go/pkg/operator/events"
+	"github.com/openshift/library-go/pkg/operator/v1helpers"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/dynamic"
+	corev1informers "k8s.io/client-go/informers/core/v1"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+)
+
+const (
+	labelPolicyAppliedConditionType = "LabelPolicyApplied"
+	labelPolicyPreviewFieldManager  = "kueue-operator-label-policy-preview"
+)
+
+// appliedLabelPolicy returns the label policy of the Kueue configuration applied last.
+// Before Kueue is configured, no object is managed, as with QueueName.
+func appliedLabelPolicy(cfgMap *corev1.ConfigMap) (kueuev1.LabelPolicy, error) {
+	if cfgMap == nil {
+		return kueuev1.LabelPolicyQueueName, nil
+	}
+	return configmap.LabelPolicy(cfgMap)
+}
+
+// manageLabelPolicySwitch keeps the QueueName label policy in kueueConfig while the
+// change to None waits for an acknowledgement. nil is returned when the change does not
+// require one.
+func (c *TargetConfigReconciler) manageLabelPolicySwitch(kueue *kueuev1.Kueue, kueueConfig *kueuev1.KueueConfiguration) (*applyoperatorv1.OperatorConditionApplyConfiguration, error) {
+	if kueue.Spec.Config.WorkloadManagement.LabelPolicySwitch != kueuev1.LabelPolicySwitchRequireAcknowledgement {
+		return nil, nil
+	}
+
+	cfgMap, err := c.kubeInformersForNamespaces.InformersFor(c.operatorNamespace).Core().V1().ConfigMaps().Lister().
+		ConfigMaps(c.operatorNamespace).Get(KueueConfigMap)
+	if apierrors.IsNotFound(err) {
+		cfgMap, err = nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ConfigMap %s/%s: %w", c.operatorNamespace, KueueConfigMap, err)
+	}
+	applied, err := appliedLabelPolicy(cfgMap)
+	if err != nil {
+		return nil, err
+	}
+
+	if labelpolicy.Held(kueue, applied) {
+		kueueConfig.WorkloadManagement.LabelPolicy = kueuev1.LabelPolicyQueueName
+		return applyoperatorv1.OperatorCondition().
+			WithType(labelPolicyAppliedConditionType).
+			WithStatus(operatorv1.ConditionFalse).
+			WithReason("AcknowledgementRequired").
+			WithMessage(fmt.Sprintf("The None label policy is applied once the Kueue CR is annotated with %s=None; the objects that would become managed are reported in status.labelPolicyPreview", labelpolicy.AcknowledgeAnnotation)), nil
+	}
+	return applyoperatorv1.OperatorCondition().
+		WithType(labelPolicyAppliedConditionType).
+		WithStatus(operatorv1.ConditionTrue).
+		WithReason("AsExpected").
+		WithMessage(fmt.Sprintf("The %s label policy is applied", labelpolicy.Desired(kueue))), nil
+}
+
+// LabelPolicyPreviewController reports in status.labelPolicyPreview the objects that
+// would become managed by Kueue with the None label policy, while the change from
+// QueueName is pending or previewed.
+type LabelPolicyPreviewController struct {
+	operatorClient    kueueconfigclient.KueueV1Interface
+	kueueClient       *operatorclient.KueueClient
+	dynamicClient     dynamic.Interface
+	namespaceLister   corev1listers.NamespaceLister
+	configMapLister   corev1listers.ConfigMapLister
+	operatorNamespace string
+}
+
+func NewLabelPolicyPreviewController(
+	operatorConfigClient kueueconfigclient.KueueV1Interface,
+	kueueClient *operatorclient.KueueClient,
+	dynamicClient dynamic.Interface,
+	kubeInformersForNamespaces v1helpers.KubeInformersForNamespaces,
+	namespaceInformer corev1informers.NamespaceInformer,
+	operatorNamespace string,
+	eventRecorder events.Recorder,
+) factory.Controller {
+	configMapInformer := kubeInformersForNamespaces.InformersFor(operatorNamespace).Core().V1().ConfigMaps()
+	c := &LabelPolicyPreviewController{
+		operatorClient:    operatorConfigClient,
+		kueueClient:       kueueClient,
+		dynamicClient:     dynamicClient,
+		namespaceLister:   namespaceInformer.Lister(),
+		configMapLister:   configMapInformer.Lister(),
+		operatorNamespace: operatorNamespace,
+	}
+
+	isManagedNamespace := func(obj interface{}) bool {
+		if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+		ns, ok := obj.(*corev1.Namespace)
+		return ok && queues.IsManagedNamespace(ns)
+	}
+
+	// The objects of the integrations are listed on every resync rather than watched.
+	return factory.New().
+		WithInformers(kueueClient.Informer(), configMapInformer.Informer()).
+		WithFilteredEventsInformers(isManagedNamespace, namespaceInformer.Informer()).
+		ResyncEvery(5*time.Minute).
+		WithSync(c.sync).
+		ToController("LabelPolicyPreviewController", eventRecorder)
+}
+
+func (c *LabelPolicyPreviewController) sync(ctx context.Context, syncCtx factory.SyncContext) error {
+	obj, exists, err := c.kueueClient.Informer().GetIndexer().GetByKey(operatorclient.OperatorConfigName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+	kueue, ok := obj.(*kueuev1.Kueue)
+	if !ok {
+		klog.Errorf("unable to convert cached object to Kueue type")
+		return nil
+	}
+	if kueue.DeletionTimestamp != nil {
+		return nil
+	}
+
+	cfgMap, err := c.configMapLister.ConfigMaps(c.operatorNamespace).Get(KueueConfigMap)
+	if apierrors.IsNotFound(err) {
+		cfgMap, err = nil, nil
+	}
+	if err != nil {
+		return err
+	}
+	applied, err := appliedLabelPolicy(cfgMap)
+	if err != nil {
+		return err
+	}
+	if !labelpolicy.Previewed(kueue, applied) {
+		if kueue.Status.LabelPolicyPreview.LabelPolicy == "" {
+			return nil
+		}
+		// Removes the preview previously reported.
+		return c.applyPreview(ctx, kueue, nil)
+	}
+
+	counts, err := c.countBecomingManaged(ctx, kueue.Spec.Config.Integrations.Frameworks)
+	if err != nil {
+		return err
+	}
+	preview := labelpolicy.BuildPreview(counts, metav1.Now())
+	current := kueue.Status.LabelPolicyPreview
+	preview.LastUpdateTime = current.LastUpdateTime
+	if equality.Semantic.DeepEqual(current, preview) {
+		return nil
+	}
+	preview.LastUpdateTime = metav1.Now()
+	return c.applyPreview(ctx, kueue, &preview)
+}
+
+// countBecomingManaged counts by namespace and integration the objects of the managed
+// namespaces that Kueue would manage with the None label policy.
+func (c *LabelPolicyPreviewController) countBecomingManaged(ctx context.Context, frameworks []kueuev1.KueueIntegration) (map[labelpolicy.Key]int32, error) {
+	namespaces, err := listManagedNamespaces(c.namespaceLister, c.operatorNamespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list managed namespaces: %w", err)
+	}
+	managed := sets.New[string]()
+	for _, ns := range namespaces {
+		managed.Insert(ns.Name)
+	}
+
+	counts := map[labelpolicy.Key]int32{}
+	if managed.Len() == 0 {
+		return counts, nil
+	}
+	for _, framework := range frameworks {
+		api, ok := integration.APIFor(framework)
+		if !ok {
+			continue
+		}
+		list, err := c.dynamicClient.Resource(api.GroupVersionResource()).List(ctx, metav1.ListOptions{LabelSelector: "!" + queueassignment.QueueNameLabel})
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s: %w", api.GroupVersionResource().GroupResource(), err)
+		}
+		for _, obj := range list.Items {
+			if managed.Has(obj.GetNamespace()) && labelpolicy.BecomesManaged(obj) {
+				counts[labelpolicy.Key{Namespace: obj.GetNamespace(), Integration: framework}]++
+			}
+		}
+	}
+	return counts, nil
+}
+
+// applyPreview applies the preview with its own field manager. A nil preview removes
+// the one previously applied.
+func (c *LabelPolicyPreviewController) applyPreview(ctx context.Context, kueue *kueuev1.Kueue, preview *kueuev1.LabelPolicyPreview) error {
+	status := applyconfigurationkueueoperatorv1.KueueStatus()
+	if preview != nil {
+		objects := make([]*applyconfigurationkueueoperatorv1.LabelPolicyPreviewObjectsApplyConfiguration, 0, len(preview.Objects))
+		for _, o := range preview.Objects {
+			objects = append(objects, applyconfigurationkueueoperatorv1.LabelPolicyPreviewObjects().
+				WithNamespace(o.Namespace).
+				WithIntegration(o.Integration).
+				WithCount(o.Count))
+		}
+		status = status.WithLabelPolicyPreview(applyconfigurationkueueoperatorv1.LabelPolicyPreview().
+			WithLabelPolicy(preview.LabelPolicy).
+			WithTotal(*preview.Total).
+			WithObjects(objects...).
+			WithLastUpdateTime(preview.LastUpdateTime))
+	}
+	config := applyconfigurationkueueoperatorv1.Kueue(kueue.Name).WithStatus(status)
+	_, err := c.operatorClient.Kueues().ApplyStatus(ctx, config, metav1.ApplyOptions{FieldManager: labelPolicyPreviewFieldManager})
+	return err
+}
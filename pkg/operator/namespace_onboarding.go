@@ -0,0 +1,184 @@
+package operator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	applyoperatorv1 "github.com/openshift/client-go/operator/applyconfigurations/operator/v1"
+	kueuev1 "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+	applyconfigurationkueueoperatorv1 "github.com/openshift/kueue-operator/pkg/generated/applyconfiguration/kueueoperator/v1"
+	kueueconfigclient "github.com/openshift/kueue-operator/pkg/generated/clientset/versioned/typed/kueueoperator/v1"
+	"github.com/openshift/kueue-operator/pkg/operator/operatorclient"
+	"github.com/openshift/kueue-operator/pkg/queues"
+	"github.com/openshift/library-go/pkg/controller/factory"
+	"github.com/openshift/library-go/pkg/operator/events"
+	"github.com/openshift/library-go/pkg/operator/v1helpers"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilerror "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	corev1informers "k8s.io/client-go/informers/core/v1"
+	appsv1listers "k8s.io/client-go/listers/apps/v1"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+)
+
+const (
+	namespacesOnboardedConditionType = "NamespacesOnboarded"
+	namespaceOnboardingFieldManager  = "kueue-operator-namespace-onboarding"
+)
+
+// NamespaceOnboardingController creates the default LocalQueue of the namespaces labeled
+// with kueue.openshift.io/managed=true, and deletes it when the label is removed.
+type NamespaceOnboardingController struct {
+	operatorClient    kueueconfigclient.KueueV1Interface
+	kueueClient       *operatorclient.KueueClient
+	dynamicClient     dynamic.Interface
+	queueInformers    dynamicinformer.DynamicSharedInformerFactory
+	namespaceLister   corev1listers.NamespaceLister
+	deploymentLister  appsv1listers.DeploymentLister
+	operatorNamespace string
+	eventRecorder     events.Recorder
+}
+
+func NewNamespaceOnboardingController(
+	operatorConfigClient kueueconfigclient.KueueV1Interface,
+	kueueClient *operatorclient.KueueClient,
+	dynamicClient dynamic.Interface,
+	queueInformers dynamicinformer.DynamicSharedInformerFactory,
+	kubeInformersForNamespaces v1helpers.KubeInformersForNamespaces,
+	namespaceInformer corev1informers.NamespaceInformer,
+	operatorNamespace string,
+	eventRecorder events.Recorder,
+) factory.Controller {
+	c := &NamespaceOnboardingController{
+		operatorClient:    operatorConfigClient,
+		kueueClient:       kueueClient,
+		dynamicClient:     dynamicClient,
+		queueInformers:    queueInformers,
+		namespaceLister:   namespaceInformer.Lister(),
+		deploymentLister:  kubeInformersForNamespaces.InformersFor(operatorNamespace).Apps().V1().Deployments().Lister(),
+		operatorNamespace: operatorNamespace,
+		eventRecorder:     eventRecorder,
+	}
+
+	// Only managed namespaces trigger a sync. A namespace whose label is removed is
+	// seen as deleted, so that its default LocalQueue is deleted too.
+	isManagedNamespace := func(obj interface{}) bool {
+		if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+		ns, ok := obj.(*corev1.Namespace)
+		return ok && queues.IsManagedNamespace(ns)
+	}
+
+	return factory.New().
+		WithInformers(
+			kueueClient.Informer(),
+			kubeInformersForNamespaces.InformersFor(operatorNamespace).Apps().V1().Deployments().Informer(),
+		).
+		WithFilteredEventsInformers(isManagedNamespace, namespaceInformer.Informer()).
+		ResyncEvery(5*time.Minute).
+		WithSync(c.sync).
+		ToController("NamespaceOnboardingController", eventRecorder)
+}
+
+func (c *NamespaceOnboardingController) sync(ctx context.Context, syncCtx factory.SyncContext) error {
+	obj, exists, err := c.kueueClient.Informer().GetIndexer().GetByKey(operatorclient.OperatorConfigName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+	kueue, ok := obj.(*kueuev1.Kueue)
+	if !ok {
+		klog.Errorf("unable to convert cached object to Kueue type")
+		return nil
+	}
+	// Queues are left in place while Kueue is being uninstalled.
+	if kueue.DeletionTimestamp != nil {
+		return nil
+	}
+
+	// Kueue validates LocalQueues through its webhooks, so nothing is done until the
+	// Kueue deployment has ready replicas.
+	deployment, err := c.deploymentLister.Deployments(c.operatorNamespace).Get(operatorclient.OperandName)
+	ready := err == nil && deployment.Status.ReadyReplicas > 0 && queueInformersSynced(c.queueInformers, []schema.GroupVersionResource{queues.LocalQueuesGVR})
+	if errors.IsNotFound(err) || (err == nil && !ready) {
+		return c.updateStatus(ctx, kueue, applyoperatorv1.OperatorCondition().
+			WithType(namespacesOnboardedConditionType).
+			WithStatus(operatorv1.ConditionFalse).
+			WithReason("WaitingForKueue").
+			WithMessage("Default LocalQueues are created once the Kueue deployment is available"))
+	}
+	if err != nil {
+		return err
+	}
+
+	namespaces, err := listManagedNamespaces(c.namespaceLister, c.operatorNamespace)
+	if err != nil {
+		return fmt.Errorf("failed to list managed namespaces: %w", err)
+	}
+	desired, err := queues.BuildDefaultLocalQueues(kueue.Spec.Queues, namespaces)
+	if err != nil {
+		return fmt.Errorf("failed to build default LocalQueues: %w", err)
+	}
+	result, err := syncQueueObjects(ctx, c.dynamicClient, c.queueInformers, []schema.GroupVersionResource{queues.LocalQueuesGVR}, queues.OnboardingSelector(), desired)
+	if err != nil {
+		return err
+	}
+	if len(result.conflicts) > 0 {
+		// A LocalQueue named default created by users is what onboarding would provide,
+		// so it is not reported as a problem.
+		klog.V(2).Infof("LocalQueues not created by the Kueue operator are left unchanged: %v", result.conflicts)
+	}
+
+	condition := applyoperatorv1.OperatorCondition().
+		WithType(namespacesOnboardedConditionType).
+		WithStatus(operatorv1.ConditionTrue).
+		WithReason("AsExpected").
+		WithMessage(fmt.Sprintf("%d of %d managed namespaces have a default LocalQueue created by the operator", len(desired)-len(result.conflicts), len(namespaces)))
+	if len(result.errs) > 0 {
+		condition = applyoperatorv1.OperatorCondition().
+			WithType(namespacesOnboardedConditionType).
+			WithStatus(operatorv1.ConditionFalse).
+			WithReason("ApplyFailed").
+			WithMessage(utilerror.NewAggregate(result.errs).Error())
+	}
+	if err := c.updateStatus(ctx, kueue, condition); err != nil {
+		return err
+	}
+	return utilerror.NewAggregate(result.errs)
+}
+
+// updateStatus applies the NamespacesOnboarded condition with its own field manager, so
+// that it is kept when the target config reconciler applies its conditions.
+func (c *NamespaceOnboardingController) updateStatus(ctx context.Context, kueue *kueuev1.Kueue, condition *applyoperatorv1.OperatorConditionApplyConfiguration) error {
+	return applyStatusConditions(ctx, c.operatorClient, kueue, namespaceOnboardingFieldManager, condition)
+}
+
+// applyStatusConditions applies the conditions of a controller with its own field
+// manager. The conditions the field manager previously applied and that are not passed
+// are removed.
+func applyStatusConditions(ctx context.Context, operatorClient kueueconfigclient.KueueV1Interface, kueue *kueuev1.Kueue, fieldManager string, conditions ...*applyoperatorv1.OperatorConditionApplyConfiguration) error {
+	status := applyconfigurationkueueoperatorv1.KueueStatus().WithConditions(conditions...)
+
+	var existingConditions []applyoperatorv1.OperatorConditionApplyConfiguration
+	extracted, err := applyconfigurationkueueoperatorv1.ExtractKueueStatus(kueue, fieldManager)
+	if err == nil && extracted.Status != nil {
+		existingConditions = extracted.Status.Conditions
+	}
+	v1helpers.SetApplyConditionsLastTransitionTime(clock.RealClock{}, &status.Conditions, existingConditions)
+
+	config := applyconfigurationkueueoperatorv1.Kueue(kueue.Name).WithStatus(status)
+	_, err = operatorClient.Kueues().ApplyStatus(ctx, config, metav1.ApplyOptions{FieldManager: fieldManager})
+	return err
+}
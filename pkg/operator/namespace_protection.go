@@ -0,0 +1,155 @@
+package operator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	applyoperatorv1 "github.com/openshift/client-go/operator/applyconfigurations/operator/v1"
+	kueuev1 "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+	"github.com/openshift/kueue-operator/pkg/namespaceprotection"
+	"github.com/openshift/kueue-operator/pkg/operator/operatorclient"
+	"github.com/openshift/kueue-operator/pkg/queues"
+	"github.com/openshift/library-go/pkg/controller/factory"
+	"github.com/openshift/library-go/pkg/operator/events"
+	"github.com/openshift/library-go/pkg/operator/resource/resourceapply"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	utilerror "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	corev1informers "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/kubernetes"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/klog/v2"
+)
+
+const (
+	namespaceProtectionReadyConditionType = "NamespaceProtectionReady"
+	operatorServiceAccountName            = "openshift-kueue-operator"
+)
+
+// manageNamespaceProtection enforces the namespace protection with a
+// ValidatingAdmissionPolicy. The policy is deleted, and nil is returned, when the
+// protection is not configured.
+func (c *TargetConfigReconciler) manageNamespaceProtection(ctx context.Context, kueue *kueuev1.Kueue) (*applyoperatorv1.OperatorConditionApplyConfiguration, error) {
+	protection := kueue.Spec.NamespaceProtection
+	if !namespaceprotection.Enabled(protection) {
+		return nil, c.cleanUpNamespaceProtection(ctx)
+	}
+
+	operatorUser := fmt.Sprintf("system:serviceaccount:%s:%s", c.operatorNamespace, operatorServiceAccountName)
+	policy, binding := namespaceprotection.BuildValidatingAdmissionPolicy(protection, operatorUser)
+	setManagedByLabel(policy)
+	setManagedByLabel(binding)
+	if _, _, err := resourceapply.ApplyValidatingAdmissionPolicyV1(ctx, c.kubeClient.AdmissionregistrationV1(), c.eventRecorder, policy, c.resourceCache); err != nil {
+		return nil, fmt.Errorf("failed to apply ValidatingAdmissionPolicy %s: %w", policy.Name, err)
+	}
+	if _, _, err := resourceapply.ApplyValidatingAdmissionPolicyBindingV1(ctx, c.kubeClient.AdmissionregistrationV1(), c.eventRecorder, binding, c.resourceCache); err != nil {
+		return nil, fmt.Errorf("failed to apply ValidatingAdmissionPolicyBinding %s: %w", binding.Name, err)
+	}
+
+	message := fmt.Sprintf("Only the members of %v may change the namespace labels %v", protection.AllowedGroups, namespaceprotection.LabelKeys(protection))
+	if namespaceprotection.BlocksRemoval(protection) {
+		message += ", which can not be removed while the namespace has admitted workloads"
+	}
+	return applyoperatorv1.OperatorCondition().
+		WithType(namespaceProtectionReadyConditionType).
+		WithStatus(operatorv1.ConditionTrue).
+		WithReason("AsExpected").
+		WithMessage(message), nil
+}
+
+// cleanUpNamespaceProtection deletes the ValidatingAdmissionPolicy of the namespace
+// protection and its binding.
+func (c *TargetConfigReconciler) cleanUpNamespaceProtection(ctx context.Context) error {
+	var errorList []error
+	client := c.kubeClient.AdmissionregistrationV1()
+	informers := c.managedInformer.Admissionregistration().V1()
+	if err := deleteCachedObject(ctx, informers.ValidatingAdmissionPolicyBindings().Lister(), client.ValidatingAdmissionPolicyBindings().Delete, namespaceprotection.PolicyName); err != nil {
+		errorList = append(errorList, fmt.Errorf("failed to delete ValidatingAdmissionPolicyBinding %s: %w", namespaceprotection.PolicyName, err))
+	}
+	if err := deleteCachedObject(ctx, informers.ValidatingAdmissionPolicies().Lister(), client.ValidatingAdmissionPolicies().Delete, namespaceprotection.PolicyName); err != nil {
+		errorList = append(errorList, fmt.Errorf("failed to delete ValidatingAdmissionPolicy %s: %w", namespaceprotection.PolicyName, err))
+	}
+	return utilerror.NewAggregate(errorList)
+}
+
+// AdmittedWorkloadsController sets the kueue.openshift.io/admitted-workloads annotation
+// on the namespaces with admitted workloads, so that the namespace protection can
+// prevent them from leaving Kueue management. The annotations are removed when the
+// protection does not block the removal of the labels.
+type AdmittedWorkloadsController struct {
+	kueueClient     *operatorclient.KueueClient
+	kubeClient      kubernetes.Interface
+	queueInformers  dynamicinformer.DynamicSharedInformerFactory
+	namespaceLister corev1listers.NamespaceLister
+}
+
+func NewAdmittedWorkloadsController(
+	kueueClient *operatorclient.KueueClient,
+	kubeClient kubernetes.Interface,
+	queueInformers dynamicinformer.DynamicSharedInformerFactory,
+	namespaceInformer corev1informers.NamespaceInformer,
+	eventRecorder events.Recorder,
+) factory.Controller {
+	c := &AdmittedWorkloadsController{
+		kueueClient:     kueueClient,
+		kubeClient:      kubeClient,
+		queueInformers:  queueInformers,
+		namespaceLister: namespaceInformer.Lister(),
+	}
+
+	// The cached LocalQueues are read on every resync rather than watched, their status
+	// changes with every admitted workload.
+	return factory.New().
+		WithInformers(kueueClient.Informer()).
+		ResyncEvery(time.Minute).
+		WithSync(c.sync).
+		ToController("AdmittedWorkloadsController", eventRecorder)
+}
+
+func (c *AdmittedWorkloadsController) sync(ctx context.Context, syncCtx factory.SyncContext) error {
+	obj, exists, err := c.kueueClient.Informer().GetIndexer().GetByKey(operatorclient.OperatorConfigName)
+	if err != nil {
+		return err
+	}
+	admitted := sets.New[string]()
+	if kueue, ok := obj.(*kueuev1.Kueue); exists && ok && kueue.DeletionTimestamp == nil && namespaceprotection.BlocksRemoval(kueue.Spec.NamespaceProtection) {
+		localQueues, synced, err := listCachedQueues(c.queueInformers, queues.LocalQueuesGVR, labels.Everything())
+		if err != nil {
+			return err
+		}
+		if !synced {
+			// The annotations are kept until the LocalQueues are cached.
+			return nil
+		}
+		admitted = namespaceprotection.NamespacesWithAdmittedWorkloads(localQueues)
+	}
+
+	namespaces, err := c.namespaceLister.List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("failed to list namespaces: %w", err)
+	}
+	var errorList []error
+	for _, ns := range namespaces {
+		_, annotated := ns.Annotations[namespaceprotection.AdmittedWorkloadsAnnotation]
+		var patch string
+		switch {
+		case admitted.Has(ns.Name) && !annotated:
+			patch = fmt.Sprintf(`{"metadata":{"annotations":{%q:"true"}}}`, namespaceprotection.AdmittedWorkloadsAnnotation)
+		case !admitted.Has(ns.Name) && annotated:
+			patch = fmt.Sprintf(`{"metadata":{"annotations":{%q:null}}}`, namespaceprotection.AdmittedWorkloadsAnnotation)
+		default:
+			continue
+		}
+		klog.V(2).Infof("Updating the %s annotation of namespace %s", namespaceprotection.AdmittedWorkloadsAnnotation, ns.Name)
+		if _, err := c.kubeClient.CoreV1().Namespaces().Patch(ctx, ns.Name, types.MergePatchType, []byte(patch), metav1.PatchOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			errorList = append(errorList, fmt.Errorf("failed to annotate namespace %s: %w", ns.Name, err))
+		}
+	}
+	return utilerror.NewAggregate(errorList)
+}
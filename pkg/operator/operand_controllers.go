@@ -0,0 +1,782 @@
+package operator
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	applyoperatorv1 "github.com/openshift/client-go/operator/applyconfigurations/operator/v1"
+	kueuev1 "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+	"github.com/openshift/kueue-operator/pkg/cert"
+	"github.com/openshift/kueue-operator/pkg/metrics"
+	"github.com/openshift/kueue-operator/pkg/operator/operatorclient"
+	"github.com/openshift/kueue-operator/pkg/tlsprofile"
+	"github.com/openshift/library-go/pkg/controller/factory"
+	appsv1 "k8s.io/api/apps/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilerror "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+	kueueconfigapi "sigs.k8s.io/kueue/apis/config/v1beta2"
+)
+
+// Names of the sub-controllers, prefixing their Degraded and Progressing conditions.
+const (
+	certificatesSubController              = "Certificates"
+	rbacSubController                      = "RBAC"
+	networkSubController                   = "Network"
+	customResourceDefinitionsSubController = "CustomResourceDefinitions"
+	webhooksSubController                  = "Webhooks"
+	operandSubController                   = "Operand"
+	monitoringSubController                = "Monitoring"
+
+	// namespacesQueueKey syncs no sub-controller, only the queues of the managed namespaces.
+	namespacesQueueKey = "Namespaces"
+
+	// networkIsolationApplyPhase applies the objects of the Network sub-controller that
+	// must come after the others.
+	networkIsolationApplyPhase = "NetworkIsolation"
+	// denyAllNetworkPolicyName is the network policy denying the traffic the others do
+	// not allow.
+	denyAllNetworkPolicyName = "kueue-deny-all"
+
+	// tenantServiceMonitorName is the ServiceMonitor of the LocalQueue metrics the users
+	// of the namespaces can query.
+	tenantServiceMonitorName = "kueue-local-queue-metrics"
+
+	// dashboardDir holds the dashboards of the OpenShift console.
+	dashboardDir = "assets/kueue-operator/dashboards"
+)
+
+// operandState is shared by the sub-controllers during a sync.
+type operandState struct {
+	kueue          *kueuev1.Kueue
+	kueueConfig    kueuev1.KueueConfiguration
+	ownerReference metav1.OwnerReference
+	// baseSpecAnnotations are completed with the hashes recorded by the sub-controllers.
+	baseSpecAnnotations map[string]string
+	// targets are the sub-controllers of the objects that changed, nil when all the
+	// sub-controllers sync.
+	targets sets.Set[string]
+	// deployment is the Kueue deployment applied by the operand sub-controller.
+	deployment *appsv1.Deployment
+}
+
+// subController applies one group of the resources Kueue is deployed with.
+type subController struct {
+	name string
+	// requires lists the sub-controllers that must be ready before this one syncs.
+	requires []string
+	// sync records in specAnnotations the hashes of the resources the Kueue deployment
+	// is rolled out on, which hold the ones of the required sub-controllers. It may return
+	// its own <name>Degraded and <name>Progressing conditions, the missing ones are
+	// derived from the returned error.
+	sync func(ctx context.Context, state *operandState, specAnnotations map[string]string) ([]*applyoperatorv1.OperatorConditionApplyConfiguration, error)
+}
+
+// subControllerResult is the outcome of the last sync of a sub-controller, reused while
+// its objects do not change.
+type subControllerResult struct {
+	conditions      []*applyoperatorv1.OperatorConditionApplyConfiguration
+	specAnnotations map[string]string
+	ready           bool
+}
+
+func (c *TargetConfigReconciler) subControllers() []subController {
+	return []subController{
+		{name: certificatesSubController, sync: c.syncCertificates},
+		{name: rbacSubController, sync: c.syncRBAC},
+		{name: networkSubController, sync: c.syncNetwork},
+		{name: customResourceDefinitionsSubController, sync: c.syncCustomResourceDefinitions},
+		{
+			name:     webhooksSubController,
+			requires: []string{certificatesSubController, networkSubController},
+			sync:     c.syncWebhooks,
+		},
+		{
+			// The Kueue deployment is rolled out on the hashes of all the other resources.
+			name: operandSubController,
+			requires: []string{
+				certificatesSubController,
+				rbacSubController,
+				networkSubController,
+				customResourceDefinitionsSubController,
+				webhooksSubController,
+			},
+			sync: c.syncOperand,
+		},
+		{name: monitoringSubController, sync: c.syncMonitoring},
+	}
+}
+
+// subControllerPhases groups the sub-controllers in phases, each one holding the
+// sub-controllers whose requirements are all in the phases before it.
+func subControllerPhases(subControllers []subController) [][]subController {
+	level := map[string]int{}
+	var phases [][]subController
+	for _, sc := range subControllers {
+		l := 0
+		for _, required := range sc.requires {
+			l = max(l, level[required]+1)
+		}
+		level[sc.name] = l
+		if l == len(phases) {
+			phases = append(phases, nil)
+		}
+		phases[l] = append(phases[l], sc)
+	}
+	return phases
+}
+
+// runSubControllers syncs the sub-controllers phase by phase, the sub-controllers of a
+// phase concurrently. A failing sub-controller only prevents the ones requiring it from
+// syncing. The conditions of all the sub-controllers are returned in order with the
+// aggregated errors.
+//
+// When the sync targets some sub-controllers, the others reuse the result of their last
+// sync unless they are not ready, or a sub-controller they require recorded new hashes.
+func (c *TargetConfigReconciler) runSubControllers(ctx context.Context, state *operandState, subControllers []subController) ([]*applyoperatorv1.OperatorConditionApplyConfiguration, error) {
+	var conditions []*applyoperatorv1.OperatorConditionApplyConfiguration
+	var errs []error
+	notReady := sets.New[string]()
+	changed := sets.New[string]()
+	for _, phase := range subControllerPhases(subControllers) {
+		results := make([]subControllerResult, len(phase))
+		skipped := make([]bool, len(phase))
+		phaseErrs := make([]error, len(phase))
+		var wg sync.WaitGroup
+		for i, sc := range phase {
+			last, synced := c.subControllerResults[sc.name]
+			if state.targets != nil && !state.targets.Has(sc.name) && synced && last.ready && !changed.HasAny(sc.requires...) {
+				metrics.RecordSubControllerSync(sc.name, true)
+				results[i], skipped[i] = last, true
+				continue
+			}
+			metrics.RecordSubControllerSync(sc.name, false)
+
+			specAnnotations := maps.Clone(state.baseSpecAnnotations)
+			for _, required := range sc.requires {
+				maps.Copy(specAnnotations, c.subControllerResults[required].specAnnotations)
+			}
+
+			if blocking := notReady.Intersection(sets.New(sc.requires...)); blocking.Len() > 0 {
+				results[i].conditions = []*applyoperatorv1.OperatorConditionApplyConfiguration{
+					subControllerCondition(sc.name, operatorv1.OperatorStatusTypeDegraded, operatorv1.ConditionFalse, "AsExpected", ""),
+					subControllerCondition(sc.name, operatorv1.OperatorStatusTypeProgressing, operatorv1.ConditionTrue, "WaitingForPrerequisites",
+						fmt.Sprintf("Waiting for %v", sets.List(blocking))),
+				}
+				continue
+			}
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				scConditions, err := sc.sync(ctx, state, specAnnotations)
+				if err != nil {
+					klog.Errorf("unable to sync %s: %v", sc.name, err)
+					phaseErrs[i] = fmt.Errorf("%s: %w", sc.name, err)
+				}
+				results[i] = subControllerResult{
+					conditions:      completeSubControllerConditions(sc.name, scConditions, err),
+					specAnnotations: specAnnotations,
+					ready:           err == nil && subControllerReady(sc.name, scConditions),
+				}
+			}()
+		}
+		wg.Wait()
+
+		for i, sc := range phase {
+			result := results[i]
+			conditions = append(conditions, result.conditions...)
+			if skipped[i] {
+				continue
+			}
+			errs = append(errs, phaseErrs[i])
+			if !result.ready {
+				notReady.Insert(sc.name)
+			}
+			if !maps.Equal(c.subControllerResults[sc.name].specAnnotations, result.specAnnotations) {
+				changed.Insert(sc.name)
+			}
+			c.subControllerResults[sc.name] = result
+		}
+	}
+	return conditions, utilerror.NewAggregate(errs)
+}
+
+// syncTargets returns the sub-controllers targeted by a sync of the queue key, nil when
+// all of them sync. Events of the objects applied by a sub-controller only sync this
+// one, the changes of the Kueue CR and the periodic resyncs sync all of them.
+func syncTargets(queueKey string) sets.Set[string] {
+	if queueKey == factory.DefaultQueueKey {
+		return nil
+	}
+	return sets.New(queueKey)
+}
+
+// subControllerQueueKeys routes the events of an informer to the sub-controller applying
+// its objects.
+func subControllerQueueKeys(name string) factory.ObjectQueueKeysFunc {
+	return func(runtime.Object) []string {
+		return []string{name}
+	}
+}
+
+// isDependencyCustomResourceDefinition filters out the events of the Kueue CRDs from the
+// cluster-wide CRD informer, they are routed to the CustomResourceDefinitions
+// sub-controller by the informer of the CRDs applied by the operator. The other CRDs
+// tell whether cert-manager and the integrations are installed, and trigger a full sync.
+func isDependencyCustomResourceDefinition(obj interface{}) bool {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	crd, ok := obj.(*apiextensionsv1.CustomResourceDefinition)
+	return !ok || crd.Spec.Group != "kueue.x-k8s.io"
+}
+
+func subControllerCondition(name, conditionType string, status operatorv1.ConditionStatus, reason, message string) *applyoperatorv1.OperatorConditionApplyConfiguration {
+	return applyoperatorv1.OperatorCondition().
+		WithType(name + conditionType).
+		WithStatus(status).
+		WithReason(reason).
+		WithMessage(message)
+}
+
+// completeSubControllerConditions adds the Degraded and Progressing conditions the
+// sub-controller did not return: Degraded when it failed, neither otherwise.
+func completeSubControllerConditions(name string, conditions []*applyoperatorv1.OperatorConditionApplyConfiguration, err error) []*applyoperatorv1.OperatorConditionApplyConfiguration {
+	hasCondition := func(conditionType string) bool {
+		return slices.ContainsFunc(conditions, func(c *applyoperatorv1.OperatorConditionApplyConfiguration) bool {
+			return c.Type != nil && *c.Type == name+conditionType
+		})
+	}
+	if !hasCondition(operatorv1.OperatorStatusTypeDegraded) {
+		degraded := subControllerCondition(name, operatorv1.OperatorStatusTypeDegraded, operatorv1.ConditionFalse, "AsExpected", "")
+		if err != nil {
+			degraded = subControllerCondition(name, operatorv1.OperatorStatusTypeDegraded, operatorv1.ConditionTrue, "ApplyFailed", err.Error())
+		}
+		conditions = append(conditions, degraded)
+	}
+	if !hasCondition(operatorv1.OperatorStatusTypeProgressing) {
+		conditions = append(conditions, subControllerCondition(name, operatorv1.OperatorStatusTypeProgressing, operatorv1.ConditionFalse, "AsExpected", ""))
+	}
+	return conditions
+}
+
+// subControllerReady reports whether the sub-controller is neither degraded nor
+// progressing according to the conditions it returned.
+func subControllerReady(name string, conditions []*applyoperatorv1.OperatorConditionApplyConfiguration) bool {
+	return !slices.ContainsFunc(conditions, func(c *applyoperatorv1.OperatorConditionApplyConfiguration) bool {
+		if c.Type == nil || c.Status == nil || *c.Status != operatorv1.ConditionTrue {
+			return false
+		}
+		return *c.Type == name+operatorv1.OperatorStatusTypeDegraded || *c.Type == name+operatorv1.OperatorStatusTypeProgressing
+	})
+}
+
+// syncCertificates applies the cert-manager Issuer and Certificates, and waits for the
+// certificates to be issued.
+func (c *TargetConfigReconciler) syncCertificates(ctx context.Context, state *operandState, specAnnotations map[string]string) ([]*applyoperatorv1.OperatorConditionApplyConfiguration, error) {
+	found, err := c.isResourceRegisteredCached(schema.GroupVersionKind{
+		Group:   "cert-manager.io",
+		Version: "v1",
+		Kind:    "Issuer",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to check cert-manager is installed: %w", err)
+	}
+	if !found {
+		klog.Errorf("please make sure that cert-manager is installed")
+		c.eventRecorder.Eventf("CertManagerMissing", "cert-manager is not installed")
+		// The CRD informer resyncs the operator once cert-manager is installed.
+		return []*applyoperatorv1.OperatorConditionApplyConfiguration{
+			subControllerCondition(certificatesSubController, operatorv1.OperatorStatusTypeDegraded, operatorv1.ConditionTrue,
+				"MissingDependency", "please make sure that cert-manager is installed on your cluster"),
+			applyoperatorv1.OperatorCondition().
+				WithType("CertManagerAvailable").
+				WithStatus(operatorv1.ConditionFalse).
+				WithReason("MissingDependency").
+				WithMessage("cert-manager is required but not installed"),
+		}, nil
+	}
+	conditions := []*applyoperatorv1.OperatorConditionApplyConfiguration{
+		applyoperatorv1.OperatorCondition().
+			WithType("CertManagerAvailable").
+			WithStatus(operatorv1.ConditionTrue).
+			WithReason("CertManagerInstalled").
+			WithMessage("cert-manager is installed"),
+	}
+
+	issuer, _, err := c.manageIssuerCR(ctx, state.kueue)
+	if err != nil {
+		return conditions, fmt.Errorf("unable to manage issuer: %w", err)
+	}
+	hash, err := computeSpecHash(issuer.Object["spec"])
+	if err != nil {
+		return conditions, fmt.Errorf("failed to hash Issuer spec: %w", err)
+	}
+	specAnnotations["issuer/"+issuer.GetName()] = hash
+
+	certificateData := []struct {
+		dnsNames        []interface{}
+		commonName      string
+		secretName      string
+		certificateName string
+	}{
+		{
+			dnsNames: []interface{}{
+				fmt.Sprintf("kueue-webhook-service.%s.svc", c.operatorNamespace),
+				fmt.Sprintf("kueue-webhook-service.%s.svc.cluster.local", c.operatorNamespace),
+			},
+			commonName:      "",
+			secretName:      "kueue-webhook-server-cert",
+			certificateName: "webhook-cert",
+		},
+		{
+			dnsNames: []interface{}{
+				fmt.Sprintf("kueue-controller-manager-metrics-service.%s.svc", c.operatorNamespace),
+				fmt.Sprintf("kueue-controller-manager-metrics-service.%s.svc.cluster.local", c.operatorNamespace),
+			},
+			commonName:      "kueue-metrics",
+			secretName:      "metrics-server-cert",
+			certificateName: "metrics-certs",
+		},
+		{
+			dnsNames: []interface{}{
+				fmt.Sprintf("kueue-visibility-server.%s.svc", c.operatorNamespace),
+				fmt.Sprintf("kueue-visibility-server.%s.svc.cluster.local", c.operatorNamespace),
+			},
+			commonName:      "kueue-visibility-server",
+			secretName:      "kueue-visibility-server-cert",
+			certificateName: "kueue-visibility-server-cert",
+		},
+	}
+
+	for _, certificate := range certificateData {
+		certificateCR, _, err := c.manageCertificateCR(ctx, state.kueue, certificate.dnsNames, certificate.commonName, certificate.secretName, certificate.certificateName)
+		if err != nil {
+			return conditions, fmt.Errorf("unable to manage certificate: %w", err)
+		}
+		hash, err = computeSpecHash(certificateCR.Object["spec"])
+		if err != nil {
+			return conditions, fmt.Errorf("failed to hash Certificate spec: %w", err)
+		}
+		specAnnotations["certificate/"+certificateCR.GetName()] = hash
+	}
+
+	// Wait for the certificates to be ready before creating webhooks
+	// This prevents webhook timeout errors when the certificate isn't provisioned yet
+	for _, certificate := range certificateData {
+		start := time.Now()
+		err := cert.WaitForCertificateReady(ctx, c.dynamicClient, c.operatorNamespace, certificate.certificateName, 2*time.Minute)
+		metrics.ObserveCertificateWait(certificate.certificateName, time.Since(start), err == nil)
+		if err != nil {
+			klog.Warningf("Certificate %s not ready yet: %v - will retry on next reconciliation", certificate.certificateName, err)
+			return append(conditions,
+				subControllerCondition(certificatesSubController, operatorv1.OperatorStatusTypeDegraded, operatorv1.ConditionFalse, "AsExpected", ""),
+				subControllerCondition(certificatesSubController, operatorv1.OperatorStatusTypeProgressing, operatorv1.ConditionTrue,
+					"WaitingForCertificates", fmt.Sprintf("Certificate %s is not ready yet", certificate.certificateName)),
+			), err
+		}
+	}
+	return conditions, nil
+}
+
+// syncRBAC applies the service account, roles, cluster roles and their bindings. The
+// bindings may be applied before the roles they refer to, all are applied concurrently.
+func (c *TargetConfigReconciler) syncRBAC(ctx context.Context, state *operandState, specAnnotations map[string]string) ([]*applyoperatorv1.OperatorConditionApplyConfiguration, error) {
+	ownerReference := state.ownerReference
+
+	roles := []string{
+		"assets/kueue-operator/role-leader-election.yaml",
+		"assets/kueue-operator/role-manager-secrets.yaml",
+	}
+	roleBindings := []string{
+		"assets/kueue-operator/rolebinding-leader-election.yaml",
+		"assets/kueue-operator/rolebinding-manager-secrets.yaml",
+	}
+	clusterRoleBindings := []string{
+		"assets/kueue-operator/clusterrolebinding-proxy.yaml",
+		"assets/kueue-operator/clusterrolebinding-manager.yaml",
+	}
+	if c.serviceMonitorSupport {
+		roles = append(roles, "assets/kueue-operator/role-prometheus.yaml")
+		clusterRoleBindings = append(clusterRoleBindings,
+			"assets/kueue-operator/clusterrolebinding-metrics.yaml",
+			"assets/kueue-operator/clusterrolebinding-metrics-auth.yaml",
+		)
+	}
+
+	steps := []applyStep{
+		func(ctx context.Context, specAnnotations map[string]string) error {
+			sa, _, err := c.manageServiceAccount(ctx, ownerReference)
+			if err != nil {
+				return fmt.Errorf("unable to manage service account: %w", err)
+			}
+			// ServiceAccount has no spec field; hash only the name to avoid
+			// including mutable metadata (resourceVersion) that causes rollout loops.
+			hash, err := computeSpecHash(sa.Name)
+			if err != nil {
+				return fmt.Errorf("failed to hash ServiceAccount: %w", err)
+			}
+			specAnnotations["serviceaccounts/"+sa.Name] = hash
+			return nil
+		},
+		func(ctx context.Context, specAnnotations map[string]string) error {
+			if err := c.manageClusterRoles(ctx, state.kueueConfig, specAnnotations, ownerReference); err != nil {
+				return fmt.Errorf("unable to manage cluster roles: %w", err)
+			}
+			return nil
+		},
+		func(ctx context.Context, specAnnotations map[string]string) error {
+			if err := c.manageExternalFrameworksRBAC(ctx, state.kueue, specAnnotations, ownerReference); err != nil {
+				return fmt.Errorf("unable to manage external frameworks rbac: %w", err)
+			}
+			return nil
+		},
+		func(ctx context.Context, specAnnotations map[string]string) error {
+			clusterRole, _, err := c.manageOpenshiftClusterRolesForKueue(ctx, ownerReference)
+			if err != nil {
+				return fmt.Errorf("unable to manage openshift cluster roles: %w", err)
+			}
+			hash, err := computeSpecHash(clusterRole.Rules)
+			if err != nil {
+				return fmt.Errorf("failed to hash ClusterRole rules: %w", err)
+			}
+			specAnnotations["clusterrole/"+clusterRole.Name] = hash
+			return nil
+		},
+		func(ctx context.Context, specAnnotations map[string]string) error {
+			clusterRoleBindingForKueue, _, err := c.manageOpenshiftClusterRolesBindingForKueue(ctx, ownerReference)
+			if err != nil {
+				return fmt.Errorf("unable to manage openshift cluster roles binding: %w", err)
+			}
+			hash, err := computeSpecHash([]interface{}{clusterRoleBindingForKueue.Subjects, clusterRoleBindingForKueue.RoleRef})
+			if err != nil {
+				return fmt.Errorf("failed to hash ClusterRoleBinding: %w", err)
+			}
+			specAnnotations["clusterrolebinding/"+clusterRoleBindingForKueue.Name] = hash
+			return nil
+		},
+		func(ctx context.Context, specAnnotations map[string]string) error {
+			roleBindingVisibility, _, err := c.manageSystemRoleBindings(ctx, "assets/kueue-operator/rolebinding-visibility-server-auth-reader.yaml", ownerReference, true)
+			if err != nil {
+				return fmt.Errorf("unable to bind role binding for visibility: %w", err)
+			}
+			hash, err := computeSpecHash([]interface{}{roleBindingVisibility.Subjects, roleBindingVisibility.RoleRef})
+			if err != nil {
+				return fmt.Errorf("failed to hash RoleBinding: %w", err)
+			}
+			specAnnotations["rolebinding/"+roleBindingVisibility.Name] = hash
+			return nil
+		},
+	}
+
+	for _, asset := range roles {
+		steps = append(steps, func(ctx context.Context, specAnnotations map[string]string) error {
+			role, _, err := c.manageRole(ctx, asset, ownerReference)
+			if err != nil {
+				return fmt.Errorf("unable to create role %s: %w", asset, err)
+			}
+			hash, err := computeSpecHash(role.Rules)
+			if err != nil {
+				return fmt.Errorf("failed to hash Role rules: %w", err)
+			}
+			specAnnotations["role/"+role.Name] = hash
+			return nil
+		})
+	}
+
+	for _, asset := range roleBindings {
+		steps = append(steps, func(ctx context.Context, specAnnotations map[string]string) error {
+			roleBinding, _, err := c.manageRoleBindings(ctx, asset, ownerReference, true)
+			if err != nil {
+				return fmt.Errorf("unable to bind role %s: %w", asset, err)
+			}
+			hash, err := computeSpecHash([]interface{}{roleBinding.Subjects, roleBinding.RoleRef})
+			if err != nil {
+				return fmt.Errorf("failed to hash RoleBinding: %w", err)
+			}
+			specAnnotations["rolebinding/"+roleBinding.Name] = hash
+			return nil
+		})
+	}
+
+	for _, asset := range clusterRoleBindings {
+		steps = append(steps, func(ctx context.Context, specAnnotations map[string]string) error {
+			clusterRoleBinding, _, err := c.manageClusterRoleBindings(ctx, asset, ownerReference)
+			if err != nil {
+				return fmt.Errorf("unable to manage cluster role binding %s: %w", asset, err)
+			}
+			hash, err := computeSpecHash([]interface{}{clusterRoleBinding.Subjects, clusterRoleBinding.RoleRef})
+			if err != nil {
+				return fmt.Errorf("failed to hash ClusterRoleBinding: %w", err)
+			}
+			specAnnotations["clusterrolebinding/"+clusterRoleBinding.Name] = hash
+			return nil
+		})
+	}
+
+	if c.serviceMonitorSupport {
+		steps = append(steps,
+			func(ctx context.Context, specAnnotations map[string]string) error {
+				prometheusRB, _, err := c.manageRoleBindings(ctx, "assets/kueue-operator/rolebinding-prometheus.yaml", ownerReference, false)
+				if err != nil {
+					return fmt.Errorf("unable to bind role prometheus: %w", err)
+				}
+				hash, err := computeSpecHash([]interface{}{prometheusRB.Subjects, prometheusRB.RoleRef})
+				if err != nil {
+					return fmt.Errorf("failed to hash RoleBinding: %w", err)
+				}
+				specAnnotations["rolebinding/"+prometheusRB.Name] = hash
+				return nil
+			},
+			func(ctx context.Context, specAnnotations map[string]string) error {
+				promCRB, _, err := c.manageClusterRoleBindingsWithoutNamespaceOverride(ctx, "assets/kueue-operator/clusterrolebinding-metrics-monitoring.yaml", ownerReference)
+				if err != nil {
+					return fmt.Errorf("unable to manage metrics monitoring cluster role binding: %w", err)
+				}
+				hash, err := computeSpecHash([]interface{}{promCRB.Subjects, promCRB.RoleRef})
+				if err != nil {
+					return fmt.Errorf("failed to hash ClusterRoleBinding: %w", err)
+				}
+				specAnnotations["clusterrolebinding/"+promCRB.Name] = hash
+				return nil
+			},
+		)
+	}
+
+	return nil, c.runApplyPhase(ctx, rbacSubController, specAnnotations, steps...)
+}
+
+// syncNetwork applies the services, the visibility APIService and its flow control, the
+// network policies and the ServiceMonitor. The APIService is applied after the service it
+// refers to, and the deny-all network policy after the others.
+func (c *TargetConfigReconciler) syncNetwork(ctx context.Context, state *operandState, specAnnotations map[string]string) ([]*applyoperatorv1.OperatorConditionApplyConfiguration, error) {
+	ownerReference := state.ownerReference
+
+	networkPolicySteps, denyAllNetworkPolicySteps, err := c.networkPolicySteps(ownerReference, state.kueueConfig.Metrics)
+	if err != nil {
+		return nil, fmt.Errorf("unable to manage network policies: %w", err)
+	}
+
+	services := []string{
+		"assets/kueue-operator/visibility-server.yaml",
+		"assets/kueue-operator/webhook-service.yaml",
+	}
+	if c.serviceMonitorSupport {
+		services = append(services, "assets/kueue-operator/controller-manager-metrics-service.yaml")
+	}
+	steps := []applyStep{
+		func(ctx context.Context, specAnnotations map[string]string) error {
+			if err := c.managePriorityLevelConfiguration(ctx, specAnnotations, ownerReference); err != nil {
+				return fmt.Errorf("unable to manage visibility prioritylevelconfiguration: %w", err)
+			}
+			return nil
+		},
+		func(ctx context.Context, specAnnotations map[string]string) error {
+			if err := c.manageFlowSchema(ctx, specAnnotations, ownerReference); err != nil {
+				return fmt.Errorf("unable to manage visibility flowschema: %w", err)
+			}
+			return nil
+		},
+	}
+	for _, asset := range services {
+		steps = append(steps, func(ctx context.Context, specAnnotations map[string]string) error {
+			service, _, err := c.manageService(ctx, asset, ownerReference)
+			if err != nil {
+				return fmt.Errorf("unable to manage service %s: %w", asset, err)
+			}
+			hash, err := computeSpecHash(service.Spec)
+			if err != nil {
+				return fmt.Errorf("failed to hash Service spec: %w", err)
+			}
+			specAnnotations["service/"+service.Name] = hash
+			return nil
+		})
+	}
+	if c.serviceMonitorSupport {
+		steps = append(steps,
+			func(ctx context.Context, specAnnotations map[string]string) error {
+				serviceMonitor, _, err := c.manageServiceMonitor(ctx, state.kueue)
+				if err != nil {
+					return fmt.Errorf("unable to manage service monitor: %w", err)
+				}
+				hash, err := computeSpecHash(serviceMonitor.Object["spec"])
+				if err != nil {
+					return fmt.Errorf("failed to hash ServiceMonitor spec: %w", err)
+				}
+				specAnnotations["servicemonitor/"+serviceMonitor.GetName()] = hash
+				return nil
+			},
+		)
+	}
+	// The certificate of the operator metrics is issued by the service CA of OpenShift.
+	if c.serviceMonitorSupport && c.isOpenShift {
+		steps = append(steps,
+			// The metrics of the operator do not roll Kueue out.
+			func(ctx context.Context, specAnnotations map[string]string) error {
+				if _, _, err := c.manageService(ctx, "assets/kueue-operator/operator-metrics-service.yaml", ownerReference); err != nil {
+					return fmt.Errorf("unable to manage operator metrics service: %w", err)
+				}
+				if _, _, err := c.manageOperatorServiceMonitor(ctx, state.kueue); err != nil {
+					return fmt.Errorf("unable to manage operator service monitor: %w", err)
+				}
+				return nil
+			},
+		)
+	}
+	steps = append(steps, networkPolicySteps...)
+	if err := c.runApplyPhase(ctx, networkSubController, specAnnotations, steps...); err != nil {
+		return nil, err
+	}
+
+	steps = append([]applyStep{
+		func(ctx context.Context, specAnnotations map[string]string) error {
+			if err := c.manageAPIService(ctx, specAnnotations, ownerReference); err != nil {
+				return fmt.Errorf("unable to manage visibility apiservice: %w", err)
+			}
+			return nil
+		},
+	}, denyAllNetworkPolicySteps...)
+	return nil, c.runApplyPhase(ctx, networkIsolationApplyPhase, specAnnotations, steps...)
+}
+
+// syncCustomResourceDefinitions applies the Kueue CRDs.
+func (c *TargetConfigReconciler) syncCustomResourceDefinitions(ctx context.Context, state *operandState, specAnnotations map[string]string) ([]*applyoperatorv1.OperatorConditionApplyConfiguration, error) {
+	if err := c.manageCustomResources(ctx, specAnnotations); err != nil {
+		return nil, fmt.Errorf("unable to manage custom resources: %w", err)
+	}
+	return nil, nil
+}
+
+// syncWebhooks applies the Kueue admission webhooks, once their service and certificate
+// are in place.
+func (c *TargetConfigReconciler) syncWebhooks(ctx context.Context, state *operandState, specAnnotations map[string]string) ([]*applyoperatorv1.OperatorConditionApplyConfiguration, error) {
+	kueueWH, _, err := c.manageMutatingWebhook(ctx, state.kueueConfig, state.ownerReference)
+	if err != nil {
+		return nil, fmt.Errorf("unable to manage mutating webhook: %w", err)
+	}
+	hash, err := computeSpecHash(kueueWH.Webhooks)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash MutatingWebhookConfiguration webhooks: %w", err)
+	}
+	specAnnotations["mutatingwebhook/"+kueueWH.Name] = hash
+
+	kueueVWH, _, err := c.manageValidatingWebhook(ctx, state.kueueConfig, state.ownerReference)
+	if err != nil {
+		return nil, fmt.Errorf("unable to manage validating webhook: %w", err)
+	}
+	hash, err = computeSpecHash(kueueVWH.Webhooks)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash ValidatingWebhookConfiguration webhooks: %w", err)
+	}
+	specAnnotations["validatingwebhook/"+kueueVWH.Name] = hash
+	return nil, nil
+}
+
+// syncOperand applies the Kueue configuration and deployment, and reports the state of
+// the deployment.
+func (c *TargetConfigReconciler) syncOperand(ctx context.Context, state *operandState, specAnnotations map[string]string) ([]*applyoperatorv1.OperatorConditionApplyConfiguration, error) {
+	// Resolve TLS security profile from APIServer cluster-wide config
+	var tlsOpts *kueueconfigapi.TLSOptions
+	if c.isOpenShift {
+		clusterProfile, err := tlsprofile.FetchAPIServerTLSProfile(ctx, c.openshiftConfigClient)
+		if err != nil {
+			klog.Warningf("Failed to fetch TLS profile from APIServer CR: %v - will retry", err)
+			return nil, err
+		}
+		tlsOpts, err = tlsprofile.TLSOptionsFromProfile(clusterProfile)
+		if err != nil {
+			klog.Errorf("Unsupported TLS profile: %v", err)
+			c.eventRecorder.Eventf("UnsupportedTLSProfile", "%v", err)
+			return c.buildUnsupportedTLSProfileConditions(err), nil
+		}
+		if tlsOpts != nil {
+			klog.Infof("TLS Options - MinVersion: %s, CipherSuites: %v", tlsOpts.MinVersion, tlsOpts.CipherSuites)
+		}
+	}
+
+	cm, _, err := c.manageConfigMap(ctx, state.kueueConfig, tlsOpts)
+	if err != nil {
+		return nil, fmt.Errorf("unable to manage config map: %w", err)
+	}
+	if cm != nil {
+		hash, err := computeSpecHash(cm.Data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash ConfigMap data: %w", err)
+		}
+		specAnnotations["configmap/"+cm.Name] = hash
+	}
+
+	deployment, _, err := c.manageDeployment(ctx, state.kueue, specAnnotations, state.ownerReference)
+	if err != nil {
+		return nil, fmt.Errorf("unable to manage deployment: %w", err)
+	}
+	state.deployment = deployment
+	return c.buildOperatorConditions(deployment), nil
+}
+
+// currentDeployment returns the Kueue deployment applied by the operand sub-controller,
+// or the one in the informer cache when the sub-controller did not sync. nil is returned
+// when Kueue is not deployed.
+func (c *TargetConfigReconciler) currentDeployment(state *operandState) (*appsv1.Deployment, error) {
+	if state.deployment != nil {
+		return state.deployment, nil
+	}
+	deployment, err := c.kubeInformersForNamespaces.InformersFor(c.operatorNamespace).Apps().V1().Deployments().Lister().
+		Deployments(c.operatorNamespace).Get(operatorclient.OperandName)
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	return deployment, err
+}
+
+// syncMonitoring applies the PrometheusRule of Kueue and the objects exposing the tenant
+// LocalQueue metrics when the Prometheus operator is installed, and the dashboards of the
+// console on OpenShift. They do not roll Kueue out.
+func (c *TargetConfigReconciler) syncMonitoring(ctx context.Context, state *operandState, specAnnotations map[string]string) ([]*applyoperatorv1.OperatorConditionApplyConfiguration, error) {
+	found, err := c.isResourceRegisteredCached(schema.GroupVersionKind{
+		Group:   "monitoring.coreos.com",
+		Version: "v1",
+		Kind:    "PrometheusRule",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to check the PrometheusRule CRD is installed: %w", err)
+	}
+
+	var steps []applyStep
+	if found {
+		steps = append(steps, func(ctx context.Context, specAnnotations map[string]string) error {
+			if _, _, err := c.managePrometheusRule(ctx, state.kueue.Spec.Monitoring, state.ownerReference); err != nil {
+				return fmt.Errorf("unable to manage prometheus rule: %w", err)
+			}
+			return nil
+		})
+	}
+	if c.serviceMonitorSupport {
+		steps = append(steps, func(ctx context.Context, specAnnotations map[string]string) error {
+			if err := c.manageTenantMetrics(ctx, state.kueue, state.ownerReference); err != nil {
+				return fmt.Errorf("unable to manage tenant metrics: %w", err)
+			}
+			return nil
+		})
+	}
+	if c.isOpenShift {
+		dashboardSteps, err := c.dashboardSteps(state.ownerReference)
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, dashboardSteps...)
+	}
+	return nil, c.runApplyPhase(ctx, monitoringSubController, specAnnotations, steps...)
+}
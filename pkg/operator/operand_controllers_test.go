@@ -0,0 +1,492 @@
+package operator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	applyoperatorv1 "github.com/openshift/client-go/operator/applyconfigurations/operator/v1"
+	kueuev1 "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+	"github.com/openshift/library-go/pkg/controller/factory"
+	"github.com/openshift/library-go/pkg/operator/events"
+	"github.com/openshift/library-go/pkg/operator/resource/resourceapply"
+	"github.com/openshift/library-go/pkg/operator/v1helpers"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/component-base/metrics/legacyregistry"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+)
+
+func phaseNames(phases [][]subController) [][]string {
+	names := [][]string{}
+	for _, phase := range phases {
+		phaseNames := []string{}
+		for _, sc := range phase {
+			phaseNames = append(phaseNames, sc.name)
+		}
+		names = append(names, phaseNames)
+	}
+	return names
+}
+
+func TestSubControllerPhases(t *testing.T) {
+	testCases := map[string]struct {
+		subControllers []subController
+		want           [][]string
+	}{
+		"operator sub-controllers": {
+			subControllers: (&TargetConfigReconciler{}).subControllers(),
+			want: [][]string{
+				{certificatesSubController, rbacSubController, networkSubController, customResourceDefinitionsSubController, monitoringSubController},
+				{webhooksSubController},
+				{operandSubController},
+			},
+		},
+		"independent sub-controllers share a phase": {
+			subControllers: []subController{{name: "A"}, {name: "B"}, {name: "C"}},
+			want:           [][]string{{"A", "B", "C"}},
+		},
+		"a sub-controller comes after the deepest requirement": {
+			subControllers: []subController{
+				{name: "A"},
+				{name: "B", requires: []string{"A"}},
+				{name: "C", requires: []string{"A", "B"}},
+				{name: "D", requires: []string{"A"}},
+			},
+			want: [][]string{{"A"}, {"B", "D"}, {"C"}},
+		},
+		"no sub-controllers": {
+			want: [][]string{},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := phaseNames(subControllerPhases(tc.subControllers))
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Unexpected phases (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// fakeSubController describes a sub-controller recording the hash of its objects under
+// its name.
+type fakeSubController struct {
+	name        string
+	requires    []string
+	hash        string
+	err         error
+	progressing bool
+}
+
+// syncRecorder records the sub-controllers synced concurrently.
+type syncRecorder struct {
+	lock   sync.Mutex
+	synced []string
+}
+
+func (r *syncRecorder) subControllers(fakes []fakeSubController) []subController {
+	subControllers := []subController{}
+	for _, f := range fakes {
+		subControllers = append(subControllers, subController{
+			name:     f.name,
+			requires: f.requires,
+			sync: func(_ context.Context, _ *operandState, specAnnotations map[string]string) ([]*applyoperatorv1.OperatorConditionApplyConfiguration, error) {
+				r.lock.Lock()
+				r.synced = append(r.synced, f.name)
+				r.lock.Unlock()
+				specAnnotations[f.name] = f.hash
+				if f.progressing {
+					return []*applyoperatorv1.OperatorConditionApplyConfiguration{
+						subControllerCondition(f.name, operatorv1.OperatorStatusTypeProgressing, operatorv1.ConditionTrue, "Waiting", ""),
+					}, f.err
+				}
+				return nil, f.err
+			},
+		})
+	}
+	return subControllers
+}
+
+// readyResult is the result of a ready sub-controller which recorded the annotations.
+func readyResult(name string, specAnnotations map[string]string) subControllerResult {
+	return subControllerResult{
+		conditions:      completeSubControllerConditions(name, nil, nil),
+		specAnnotations: specAnnotations,
+		ready:           true,
+	}
+}
+
+func conditionSummaries(conditions []*applyoperatorv1.OperatorConditionApplyConfiguration) []string {
+	summaries := []string{}
+	for _, c := range conditions {
+		if ptr.Deref(c.Reason, "") == "AsExpected" {
+			continue
+		}
+		summaries = append(summaries, fmt.Sprintf("%s=%s %s", ptr.Deref(c.Type, ""), ptr.Deref(c.Status, ""), ptr.Deref(c.Reason, "")))
+	}
+	return summaries
+}
+
+// subControllerSkips returns the number of skipped syncs of each sub-controller.
+func subControllerSkips(t *testing.T) map[string]float64 {
+	t.Helper()
+	families, err := legacyregistry.DefaultGatherer.Gather()
+	if err != nil {
+		t.Fatalf("failed to gather the metrics: %v", err)
+	}
+	skips := map[string]float64{}
+	for _, family := range families {
+		if family.GetName() != "openshift_kueue_operator_subcontroller_syncs_total" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			labels := map[string]string{}
+			for _, label := range metric.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+			if labels["result"] == "skipped" {
+				skips[labels["subcontroller"]] = metric.GetCounter().GetValue()
+			}
+		}
+	}
+	return skips
+}
+
+func TestRunSubControllers(t *testing.T) {
+	// B requires A, C is independent.
+	lastResults := func() map[string]subControllerResult {
+		return map[string]subControllerResult{
+			"A": readyResult("A", map[string]string{"base": "1", "A": "a"}),
+			"B": readyResult("B", map[string]string{"base": "1", "A": "a", "B": "b"}),
+			"C": readyResult("C", map[string]string{"base": "1", "C": "c"}),
+		}
+	}
+	testCases := map[string]struct {
+		lastResults    map[string]subControllerResult
+		targets        sets.Set[string]
+		subControllers []fakeSubController
+		wantSynced     []string
+		// wantSkipped are the sub-controllers counted as skipped.
+		wantSkipped    []string
+		wantErr        string
+		wantConditions []string
+		// wantAnnotations are the annotations recorded by the sub-controllers, when
+		// checked.
+		wantAnnotations map[string]map[string]string
+	}{
+		"full sync passes the hashes of the requirements": {
+			subControllers: []fakeSubController{
+				{name: "A", hash: "a"},
+				{name: "B", requires: []string{"A"}, hash: "b"},
+				{name: "C", hash: "c"},
+			},
+			wantSynced:     []string{"A", "B", "C"},
+			wantSkipped:    []string{},
+			wantConditions: []string{},
+			wantAnnotations: map[string]map[string]string{
+				"A": {"base": "1", "A": "a"},
+				"B": {"base": "1", "A": "a", "B": "b"},
+				"C": {"base": "1", "C": "c"},
+			},
+		},
+		"failing sub-controller blocks the ones requiring it": {
+			subControllers: []fakeSubController{
+				{name: "A", hash: "a", err: errors.New("boom")},
+				{name: "B", requires: []string{"A"}, hash: "b"},
+				{name: "C", hash: "c"},
+			},
+			wantSynced:  []string{"A", "C"},
+			wantSkipped: []string{},
+			wantErr:     "A: boom",
+			wantConditions: []string{
+				"ADegraded=True ApplyFailed",
+				"BProgressing=True WaitingForPrerequisites",
+			},
+		},
+		"progressing sub-controller blocks the ones requiring it": {
+			subControllers: []fakeSubController{
+				{name: "A", hash: "a", progressing: true},
+				{name: "B", requires: []string{"A"}, hash: "b"},
+			},
+			wantSynced:  []string{"A"},
+			wantSkipped: []string{},
+			wantConditions: []string{
+				"AProgressing=True Waiting",
+				"BProgressing=True WaitingForPrerequisites",
+			},
+		},
+		"errors are aggregated": {
+			subControllers: []fakeSubController{
+				{name: "A", hash: "a", err: errors.New("boom")},
+				{name: "C", hash: "c", err: errors.New("bang")},
+			},
+			wantSynced:  []string{"A", "C"},
+			wantSkipped: []string{},
+			wantErr:     "[A: boom, C: bang]",
+			wantConditions: []string{
+				"ADegraded=True ApplyFailed",
+				"CDegraded=True ApplyFailed",
+			},
+		},
+		"targeted sync skips the other ready sub-controllers": {
+			lastResults: lastResults(),
+			targets:     sets.New("C"),
+			subControllers: []fakeSubController{
+				{name: "A", hash: "a"},
+				{name: "B", requires: []string{"A"}, hash: "b"},
+				{name: "C", hash: "c"},
+			},
+			wantSynced:     []string{"C"},
+			wantSkipped:    []string{"A", "B"},
+			wantConditions: []string{},
+		},
+		"targeted sync propagates changed hashes": {
+			lastResults: lastResults(),
+			targets:     sets.New("A"),
+			subControllers: []fakeSubController{
+				{name: "A", hash: "changed"},
+				{name: "B", requires: []string{"A"}, hash: "b"},
+				{name: "C", hash: "c"},
+			},
+			wantSynced:  []string{"A", "B"},
+			wantSkipped: []string{"C"},
+			wantAnnotations: map[string]map[string]string{
+				"A": {"base": "1", "A": "changed"},
+				"B": {"base": "1", "A": "changed", "B": "b"},
+				"C": {"base": "1", "C": "c"},
+			},
+		},
+		"targeted sync does not propagate unchanged hashes": {
+			lastResults: lastResults(),
+			targets:     sets.New("A"),
+			subControllers: []fakeSubController{
+				{name: "A", hash: "a"},
+				{name: "B", requires: []string{"A"}, hash: "b"},
+				{name: "C", hash: "c"},
+			},
+			wantSynced:  []string{"A"},
+			wantSkipped: []string{"B", "C"},
+		},
+		"targeted sync syncs the sub-controllers that are not ready": {
+			lastResults: func() map[string]subControllerResult {
+				results := lastResults()
+				results["B"] = subControllerResult{specAnnotations: results["B"].specAnnotations}
+				return results
+			}(),
+			targets: sets.New("C"),
+			subControllers: []fakeSubController{
+				{name: "A", hash: "a"},
+				{name: "B", requires: []string{"A"}, hash: "b"},
+				{name: "C", hash: "c"},
+			},
+			wantSynced:  []string{"B", "C"},
+			wantSkipped: []string{"A"},
+		},
+		"targeted sync syncs the sub-controllers never synced": {
+			targets: sets.New("C"),
+			subControllers: []fakeSubController{
+				{name: "A", hash: "a"},
+				{name: "C", hash: "c"},
+			},
+			wantSynced:  []string{"A", "C"},
+			wantSkipped: []string{},
+		},
+		"namespaces sync no sub-controller": {
+			lastResults: lastResults(),
+			targets:     syncTargets(namespacesQueueKey),
+			subControllers: []fakeSubController{
+				{name: "A", hash: "a"},
+				{name: "B", requires: []string{"A"}, hash: "b"},
+				{name: "C", hash: "c"},
+			},
+			wantSynced:     []string{},
+			wantSkipped:    []string{"A", "B", "C"},
+			wantConditions: []string{},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			c := &TargetConfigReconciler{subControllerResults: tc.lastResults}
+			if c.subControllerResults == nil {
+				c.subControllerResults = map[string]subControllerResult{}
+			}
+			recorder := &syncRecorder{synced: []string{}}
+			state := &operandState{
+				baseSpecAnnotations: map[string]string{"base": "1"},
+				targets:             tc.targets,
+			}
+
+			skipsBefore := subControllerSkips(t)
+			conditions, err := c.runSubControllers(context.Background(), state, recorder.subControllers(tc.subControllers))
+			skipsAfter := subControllerSkips(t)
+
+			gotErr := ""
+			if err != nil {
+				gotErr = err.Error()
+			}
+			if gotErr != tc.wantErr {
+				t.Errorf("Unexpected error: got %q, want %q", gotErr, tc.wantErr)
+			}
+			slices.Sort(recorder.synced)
+			if diff := cmp.Diff(tc.wantSynced, recorder.synced); diff != "" {
+				t.Errorf("Unexpected synced sub-controllers (-want,+got):\n%s", diff)
+			}
+			gotSkipped := []string{}
+			for _, sc := range tc.subControllers {
+				if skipsAfter[sc.name] > skipsBefore[sc.name] {
+					gotSkipped = append(gotSkipped, sc.name)
+				}
+			}
+			if diff := cmp.Diff(tc.wantSkipped, gotSkipped); diff != "" {
+				t.Errorf("Unexpected skipped sub-controllers (-want,+got):\n%s", diff)
+			}
+			if tc.wantConditions != nil {
+				if diff := cmp.Diff(tc.wantConditions, conditionSummaries(conditions)); diff != "" {
+					t.Errorf("Unexpected conditions (-want,+got):\n%s", diff)
+				}
+			}
+			if tc.wantAnnotations != nil {
+				gotAnnotations := map[string]map[string]string{}
+				for name, result := range c.subControllerResults {
+					gotAnnotations[name] = result.specAnnotations
+				}
+				if diff := cmp.Diff(tc.wantAnnotations, gotAnnotations); diff != "" {
+					t.Errorf("Unexpected annotations (-want,+got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestSyncTargets(t *testing.T) {
+	testCases := map[string]struct {
+		queueKey string
+		want     sets.Set[string]
+	}{
+		"default queue key syncs all the sub-controllers": {
+			queueKey: factory.DefaultQueueKey,
+		},
+		"sub-controller queue key": {
+			queueKey: networkSubController,
+			want:     sets.New(networkSubController),
+		},
+		"namespaces queue key": {
+			queueKey: namespacesQueueKey,
+			want:     sets.New(namespacesQueueKey),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, syncTargets(tc.queueKey)); diff != "" {
+				t.Errorf("Unexpected targets (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSubControllerQueueKeys(t *testing.T) {
+	got := subControllerQueueKeys(webhooksSubController)(&corev1.Secret{})
+	if diff := cmp.Diff([]string{webhooksSubController}, got); diff != "" {
+		t.Errorf("Unexpected queue keys (-want,+got):\n%s", diff)
+	}
+}
+
+func TestIsDependencyCustomResourceDefinition(t *testing.T) {
+	crd := func(group string) *apiextensionsv1.CustomResourceDefinition {
+		return &apiextensionsv1.CustomResourceDefinition{
+			Spec: apiextensionsv1.CustomResourceDefinitionSpec{Group: group},
+		}
+	}
+	testCases := map[string]struct {
+		obj  interface{}
+		want bool
+	}{
+		"Kueue CRD": {
+			obj:  crd("kueue.x-k8s.io"),
+			want: false,
+		},
+		"cert-manager CRD": {
+			obj:  crd("cert-manager.io"),
+			want: true,
+		},
+		"deleted Kueue CRD": {
+			obj:  cache.DeletedFinalStateUnknown{Key: "workloads.kueue.x-k8s.io", Obj: crd("kueue.x-k8s.io")},
+			want: false,
+		},
+		"deleted integration CRD": {
+			obj:  cache.DeletedFinalStateUnknown{Key: "jobsets.jobset.x-k8s.io", Obj: crd("jobset.x-k8s.io")},
+			want: true,
+		},
+		"unexpected object": {
+			obj:  &corev1.Secret{},
+			want: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := isDependencyCustomResourceDefinition(tc.obj); got != tc.want {
+				t.Errorf("isDependencyCustomResourceDefinition() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNetworkPolicyStepsApplyDenyAllLast(t *testing.T) {
+	const namespace = "openshift-kueue-operator"
+	kubeClient := fake.NewClientset()
+	c := &TargetConfigReconciler{
+		kubeClient:                 kubeClient,
+		kubeInformersForNamespaces: v1helpers.NewKubeInformersForNamespaces(kubeClient, namespace),
+		operatorNamespace:          namespace,
+		eventRecorder:              events.NewInMemoryRecorder("test", clock.RealClock{}),
+		resourceCache:              resourceapply.NewResourceCache(),
+		applySlots:                 make(chan struct{}, maxConcurrentApplies),
+	}
+	allowSteps, denyAllSteps, err := c.networkPolicySteps(metav1.OwnerReference{}, kueuev1.Metrics{})
+	if err != nil {
+		t.Fatalf("networkPolicySteps() failed: %v", err)
+	}
+
+	ctx := context.Background()
+	policyNames := func() []string {
+		policies, err := kubeClient.NetworkingV1().NetworkPolicies(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			t.Fatalf("failed to list network policies: %v", err)
+		}
+		names := []string{}
+		for _, policy := range policies.Items {
+			names = append(names, policy.Name)
+		}
+		return names
+	}
+
+	specAnnotations := map[string]string{}
+	if err := c.runApplyPhase(ctx, networkSubController, specAnnotations, allowSteps...); err != nil {
+		t.Fatalf("failed to apply the network policies: %v", err)
+	}
+	allowed := policyNames()
+	if len(allowed) == 0 || slices.Contains(allowed, denyAllNetworkPolicyName) {
+		t.Errorf("Unexpected network policies applied before %s: %v", denyAllNetworkPolicyName, allowed)
+	}
+
+	if err := c.runApplyPhase(ctx, networkIsolationApplyPhase, specAnnotations, denyAllSteps...); err != nil {
+		t.Fatalf("failed to apply the deny-all network policy: %v", err)
+	}
+	if diff := cmp.Diff(append(allowed, denyAllNetworkPolicyName), policyNames()); diff != "" {
+		t.Errorf("Unexpected network policies (-want,+got):\n%s", diff)
+	}
+	if _, ok := specAnnotations["networkpolicy/"+denyAllNetworkPolicyName]; !ok {
+		t.Errorf("The hash of %s is not recorded: %v", denyAllNetworkPolicyName, specAnnotations)
+	}
+}
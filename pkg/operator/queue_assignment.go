@@ -0,0 +1,298 @@
+package operator
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	applyoperatorv1 "github.com/openshift/client-go/operator/applyconfigurations/operator/v1"
+	kueuev1 "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+	"github.com/openshift/kueue-operator/pkg/cert"
+	kueuelisters "github.com/openshift/kueue-operator/pkg/generated/listers/kueueoperator/v1"
+	"github.com/openshift/kueue-operator/pkg/operator/operatorclient"
+	"github.com/openshift/kueue-operator/pkg/queueassignment"
+	"github.com/openshift/kueue-operator/pkg/tlsprofile"
+	utilresourceapply "github.com/openshift/kueue-operator/pkg/util/resourceapply"
+	"github.com/openshift/library-go/pkg/operator/resource/resourceapply"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	utilerror "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/kubernetes"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+)
+
+const (
+	queueAssignmentReadyConditionType = "QueueAssignmentReady"
+
+	// webhookServerPodLabel marks the operator pod serving the webhooks of the operator.
+	// Only the leader runs the webhook server, so the Service in front of it only
+	// selects the pod with this label.
+	webhookServerPodLabel = "kueue.openshift.io/webhook-server"
+	webhookServerPort     = 9443
+	// webhookCertificateName is the cert-manager Certificate of the webhook server of
+	// the operator, and the Secret it is stored in.
+	webhookCertificateName = "kueue-operator-webhook-cert"
+)
+
+var mutatingAdmissionPolicyGVK = schema.GroupVersionKind{
+	Group:   "admissionregistration.k8s.io",
+	Version: "v1beta1",
+	Kind:    "MutatingAdmissionPolicy",
+}
+
+// manageQueueAssignment enforces the queue assignment policy with a
+// MutatingAdmissionPolicy when the API is served, and otherwise with the webhook served
+// by the operator. Whatever is not used is deleted. It returns nil when no policy is
+// configured.
+func (c *TargetConfigReconciler) manageQueueAssignment(ctx context.Context, kueue *kueuev1.Kueue, kueueCfg kueuev1.KueueConfiguration) (*applyoperatorv1.OperatorConditionApplyConfiguration, error) {
+	rules := kueue.Spec.Queues.AssignmentPolicy.Rules
+	if len(rules) == 0 {
+		return nil, c.cleanUpQueueAssignment(ctx, c.mapSupported, true)
+	}
+	resources := queueassignment.Resources(kueueCfg)
+
+	if c.mapSupported {
+		policy, binding := queueassignment.BuildMutatingAdmissionPolicy(rules, resources, c.operatorNamespace)
+		setManagedByLabel(policy)
+		setManagedByLabel(binding)
+		if _, _, err := utilresourceapply.ApplyMutatingAdmissionPolicyV1beta1(ctx, c.kubeClient.AdmissionregistrationV1beta1(), c.eventRecorder, policy); err != nil {
+			return nil, fmt.Errorf("failed to apply MutatingAdmissionPolicy %s: %w", policy.Name, err)
+		}
+		if _, _, err := utilresourceapply.ApplyMutatingAdmissionPolicyBindingV1beta1(ctx, c.kubeClient.AdmissionregistrationV1beta1(), c.eventRecorder, binding); err != nil {
+			return nil, fmt.Errorf("failed to apply MutatingAdmissionPolicyBinding %s: %w", binding.Name, err)
+		}
+		if err := c.cleanUpQueueAssignment(ctx, false, true); err != nil {
+			return nil, err
+		}
+		return applyoperatorv1.OperatorCondition().
+			WithType(queueAssignmentReadyConditionType).
+			WithStatus(operatorv1.ConditionTrue).
+			WithReason("MutatingAdmissionPolicy").
+			WithMessage(fmt.Sprintf("%d queue assignment rules are enforced by MutatingAdmissionPolicy %s", len(rules), queueassignment.PolicyName)), nil
+	}
+
+	ownerReference := metav1.OwnerReference{
+		APIVersion: "kueue.openshift.io/v1",
+		Kind:       "Kueue",
+		Name:       kueue.Name,
+		UID:        kueue.UID,
+	}
+	if _, _, err := c.manageService(ctx, "assets/kueue-operator/operator-webhook-service.yaml", ownerReference); err != nil {
+		return nil, fmt.Errorf("failed to apply the operator webhook Service: %w", err)
+	}
+	if _, _, err := c.manageCertificateCR(ctx, kueue, []interface{}{
+		fmt.Sprintf("%s.%s.svc", queueassignment.WebhookServiceName, c.operatorNamespace),
+		fmt.Sprintf("%s.%s.svc.cluster.local", queueassignment.WebhookServiceName, c.operatorNamespace),
+	}, "", webhookCertificateName, webhookCertificateName); err != nil {
+		return nil, fmt.Errorf("failed to apply the operator webhook Certificate: %w", err)
+	}
+	webhook := queueassignment.BuildMutatingWebhookConfiguration(resources, c.operatorNamespace)
+	webhook.OwnerReferences = []metav1.OwnerReference{ownerReference}
+	webhook.Annotations = cert.InjectCertAnnotationFrom(webhook.Annotations, c.operatorNamespace, webhookCertificateName)
+	setManagedByLabel(webhook)
+	if _, _, err := resourceapply.ApplyMutatingWebhookConfigurationImproved(ctx, c.kubeClient.AdmissionregistrationV1(), c.eventRecorder, webhook, c.resourceCache); err != nil {
+		return nil, fmt.Errorf("failed to apply MutatingWebhookConfiguration %s: %w", webhook.Name, err)
+	}
+	return applyoperatorv1.OperatorCondition().
+		WithType(queueAssignmentReadyConditionType).
+		WithStatus(operatorv1.ConditionTrue).
+		WithReason("Webhook").
+		WithMessage(fmt.Sprintf("MutatingAdmissionPolicies are not available, %d queue assignment rules are enforced by the webhook of the operator", len(rules))), nil
+}
+
+// cleanUpQueueAssignment deletes the MutatingAdmissionPolicy and binding, when the API is
+// served, and the MutatingWebhookConfiguration of the queue assignment policy.
+func (c *TargetConfigReconciler) cleanUpQueueAssignment(ctx context.Context, policy, webhook bool) error {
+	var errorList []error
+	if policy {
+		client := c.kubeClient.AdmissionregistrationV1beta1()
+		informers := c.managedInformer.Admissionregistration().V1beta1()
+		if err := deleteCachedObject(ctx, informers.MutatingAdmissionPolicyBindings().Lister(), client.MutatingAdmissionPolicyBindings().Delete, queueassignment.PolicyName); err != nil {
+			errorList = append(errorList, fmt.Errorf("failed to delete MutatingAdmissionPolicyBinding %s: %w", queueassignment.PolicyName, err))
+		}
+		if err := deleteCachedObject(ctx, informers.MutatingAdmissionPolicies().Lister(), client.MutatingAdmissionPolicies().Delete, queueassignment.PolicyName); err != nil {
+			errorList = append(errorList, fmt.Errorf("failed to delete MutatingAdmissionPolicy %s: %w", queueassignment.PolicyName, err))
+		}
+	}
+	if webhook {
+		lister := c.managedInformer.Admissionregistration().V1().MutatingWebhookConfigurations().Lister()
+		if err := deleteCachedObject(ctx, lister, c.kubeClient.AdmissionregistrationV1().MutatingWebhookConfigurations().Delete, queueassignment.WebhookName); err != nil {
+			errorList = append(errorList, fmt.Errorf("failed to delete MutatingWebhookConfiguration %s: %w", queueassignment.WebhookName, err))
+		}
+	}
+	return utilerror.NewAggregate(errorList)
+}
+
+// deleteCachedObject deletes an object applied by the operator when it is in the cache
+// of its informer, so that the syncs do not call the API server for the objects which
+// are already deleted.
+func deleteCachedObject[T any](ctx context.Context, lister interface{ Get(string) (T, error) }, deleteFunc func(context.Context, string, metav1.DeleteOptions) error, name string) error {
+	if _, err := lister.Get(name); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if err := deleteFunc(ctx, name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// cleanUpAdmissionPolicies deletes the admission policies of the queue assignment
+// policy, the tenant guardrails and the namespace protection when Kueue is
+// uninstalled. The webhook configuration is deleted with the other Kueue webhooks.
+func (c *TargetConfigReconciler) cleanUpAdmissionPolicies(ctx context.Context) error {
+	if err := c.cleanUpTenantGuardrails(ctx); err != nil {
+		return err
+	}
+	if err := c.cleanUpNamespaceProtection(ctx); err != nil {
+		return err
+	}
+	return c.cleanUpQueueAssignment(ctx, c.mapSupported, false)
+}
+
+// webhookServer serves the webhooks of the operator on the leader.
+type webhookServer struct {
+	secretLister corev1listers.SecretLister
+	// apiServerLister caches the APIServer of the cluster on OpenShift, whose TLS profile
+	// is applied to the connections. It is nil on the other clusters.
+	apiServerLister cache.GenericLister
+	namespace       string
+
+	lock            sync.Mutex
+	resourceVersion string
+	certificate     *tls.Certificate
+	// tlsConfig is resolved from the APIServer with tlsResourceVersion.
+	tlsResourceVersion string
+	tlsConfig          *tls.Config
+}
+
+// getCertificate loads the serving certificate issued by cert-manager from the
+// informer cache, so that renewed certificates are used without a restart.
+func (s *webhookServer) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	secret, err := s.secretLister.Secrets(s.namespace).Get(webhookCertificateName)
+	if err != nil {
+		return nil, err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.certificate != nil && s.resourceVersion == secret.ResourceVersion {
+		return s.certificate, nil
+	}
+	certificate, err := tls.X509KeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+	if err != nil {
+		return nil, err
+	}
+	s.certificate, s.resourceVersion = &certificate, secret.ResourceVersion
+	return s.certificate, nil
+}
+
+// getConfigForClient resolves the TLS configuration of the connections from the TLS
+// profile of the cluster, as for Kueue, so that profile changes are applied without a
+// restart. TLS 1.2 is the minimum version when the profile can not be resolved.
+func (s *webhookServer) getConfigForClient(*tls.ClientHelloInfo) (*tls.Config, error) {
+	defaultConfig := &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: s.getCertificate,
+	}
+	if s.apiServerLister == nil {
+		return defaultConfig, nil
+	}
+	obj, err := s.apiServerLister.Get("cluster")
+	if err != nil {
+		klog.Warningf("Failed to get the APIServer from cache, using the default TLS configuration for the webhook server: %v", err)
+		return defaultConfig, nil
+	}
+	apiServer := &configv1.APIServer{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.(*unstructured.Unstructured).Object, apiServer); err != nil {
+		return nil, err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.tlsConfig != nil && s.tlsResourceVersion == apiServer.ResourceVersion {
+		return s.tlsConfig, nil
+	}
+	tlsConfig := defaultConfig.Clone()
+	tlsOpts, err := tlsprofile.TLSOptionsFromProfile(apiServer.Spec.TLSSecurityProfile)
+	if err == nil {
+		err = tlsprofile.ApplyToTLSConfig(tlsConfig, tlsOpts)
+	}
+	if err != nil {
+		klog.Errorf("Unsupported TLS profile, using the default TLS configuration for the webhook server: %v", err)
+		tlsConfig = defaultConfig
+	}
+	s.tlsConfig, s.tlsResourceVersion = tlsConfig, apiServer.ResourceVersion
+	return s.tlsConfig, nil
+}
+
+// startWebhookServer serves the queue assignment webhook and labels the pod of the
+// operator so that the webhook Service selects it.
+func startWebhookServer(ctx context.Context, kubeClient kubernetes.Interface, secretLister corev1listers.SecretLister, apiServerLister cache.GenericLister, namespaceLister corev1listers.NamespaceLister, kueueLister kueuelisters.KueueLister, namespace string) error {
+	server := &webhookServer{secretLister: secretLister, apiServerLister: apiServerLister, namespace: namespace}
+	mux := http.NewServeMux()
+	mux.Handle(queueassignment.WebhookPath, &queueassignment.Handler{
+		Rules: func() []kueuev1.QueueAssignmentRule {
+			kueue, err := kueueLister.Get(operatorclient.OperatorConfigName)
+			if err != nil {
+				return nil
+			}
+			return kueue.Spec.Queues.AssignmentPolicy.Rules
+		},
+		NamespaceLabels: func(name string) (map[string]string, error) {
+			ns, err := namespaceLister.Get(name)
+			if err != nil {
+				return nil, err
+			}
+			return ns.Labels, nil
+		},
+	})
+	httpServer := &http.Server{
+		Addr:              fmt.Sprintf(":%d", webhookServerPort),
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+		TLSConfig: &tls.Config{
+			MinVersion:         tls.VersionTLS12,
+			GetConfigForClient: server.getConfigForClient,
+		},
+	}
+	go func() {
+		if err := httpServer.ListenAndServeTLS("", ""); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			klog.Errorf("webhook server failed: %v", err)
+		}
+	}()
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := httpServer.Shutdown(shutdownCtx); err != nil {
+			klog.Errorf("failed to shut down the webhook server: %v", err)
+		}
+	}()
+
+	podName, err := os.Hostname()
+	if err != nil {
+		return fmt.Errorf("unable to get the name of the operator pod: %w", err)
+	}
+	patch := fmt.Sprintf(`{"metadata":{"labels":{%q:"true"}}}`, webhookServerPodLabel)
+	if _, err := kubeClient.CoreV1().Pods(namespace).Patch(ctx, podName, types.MergePatchType, []byte(patch), metav1.PatchOptions{}); err != nil {
+		return fmt.Errorf("unable to label the operator pod %s: %w", podName, err)
+	}
+	return nil
+}
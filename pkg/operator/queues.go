@@ -0,0 +1,271 @@
+package operator
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	applyoperatorv1 "github.com/openshift/client-go/operator/applyconfigurations/operator/v1"
+	kueuev1 "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+	"github.com/openshift/kueue-operator/pkg/queues"
+	"github.com/openshift/library-go/pkg/operator/v1helpers"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilerror "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+)
+
+const queuesInSyncConditionType = "QueuesInSync"
+
+// queueGVRs are the resources of the queues created by the operator, in the order they
+// are created. They are deleted in the reverse order.
+var queueGVRs = []schema.GroupVersionResource{
+	queues.ResourceFlavorsGVR,
+	queues.CohortsGVR,
+	queues.ClusterQueuesGVR,
+	queues.LocalQueuesGVR,
+}
+
+// listManagedNamespaces returns the namespaces opted in to Kueue sorted by name,
+// excluding the namespaces the Kueue webhooks never act on.
+func listManagedNamespaces(lister corev1listers.NamespaceLister, operatorNamespace string) ([]*corev1.Namespace, error) {
+	selector, err := metav1.LabelSelectorAsSelector(queues.ManagedNamespaceSelector())
+	if err != nil {
+		return nil, err
+	}
+	namespaces, err := lister.List(selector)
+	if err != nil {
+		return nil, err
+	}
+	managed := []*corev1.Namespace{}
+	for _, ns := range namespaces {
+		if ns.DeletionTimestamp != nil || ns.Name == operatorNamespace || ns.Name == "kube-system" {
+			continue
+		}
+		managed = append(managed, ns)
+	}
+	slices.SortFunc(managed, func(a, b *corev1.Namespace) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return managed, nil
+}
+
+// queueSyncResult describes the changes made by syncQueueObjects.
+type queueSyncResult struct {
+	// drifted are the queues that were modified outside of the operator and reset.
+	drifted []string
+	// conflicts are the queues that exist without the operator's managed-by label.
+	conflicts []string
+	errs      []error
+}
+
+// newQueueInformers returns the informers caching the Kueue queues. They are not waited
+// for by the controllers, as the Kueue CRDs are installed by the operator once it runs:
+// listCachedQueues reports whether they are synced.
+func newQueueInformers(dynamicClient dynamic.Interface) dynamicinformer.DynamicSharedInformerFactory {
+	queueInformers := dynamicinformer.NewDynamicSharedInformerFactory(dynamicClient, 10*time.Minute)
+	for _, gvr := range queueGVRs {
+		queueInformers.ForResource(gvr)
+	}
+	return queueInformers
+}
+
+// queueInformersSynced reports whether the queue informers of the resources are synced.
+func queueInformersSynced(queueInformers dynamicinformer.DynamicSharedInformerFactory, gvrs []schema.GroupVersionResource) bool {
+	for _, gvr := range gvrs {
+		if !queueInformers.ForResource(gvr).Informer().HasSynced() {
+			return false
+		}
+	}
+	return true
+}
+
+// listCachedQueues returns copies of the queues of the resource matching selector from the
+// queue informers, and false when they are not synced yet.
+func listCachedQueues(queueInformers dynamicinformer.DynamicSharedInformerFactory, gvr schema.GroupVersionResource, selector labels.Selector) ([]unstructured.Unstructured, bool, error) {
+	informer := queueInformers.ForResource(gvr)
+	if !informer.Informer().HasSynced() {
+		return nil, false, nil
+	}
+	objs, err := informer.Lister().List(selector)
+	if err != nil {
+		return nil, true, fmt.Errorf("failed to list %s: %w", gvr.Resource, err)
+	}
+	items := make([]unstructured.Unstructured, 0, len(objs))
+	for _, obj := range objs {
+		if u, ok := obj.(*unstructured.Unstructured); ok {
+			items = append(items, *u.DeepCopy())
+		}
+	}
+	return items, true, nil
+}
+
+// syncQueueObjects creates the desired queues, corrects the ones that drifted and deletes
+// the queues matching selector that are not desired. The existing queues are read from
+// the queue informers, in the order queues are created, and deleted in the reverse order.
+func syncQueueObjects(ctx context.Context, dynamicClient dynamic.Interface, queueInformers dynamicinformer.DynamicSharedInformerFactory, gvrs []schema.GroupVersionResource, selector string, desired []queues.Object) (queueSyncResult, error) {
+	labelSelector, err := labels.Parse(selector)
+	if err != nil {
+		return queueSyncResult{}, err
+	}
+	existing := map[string]queues.Object{}
+	for _, gvr := range gvrs {
+		items, synced, err := listCachedQueues(queueInformers, gvr, labelSelector)
+		if err != nil {
+			return queueSyncResult{}, err
+		}
+		if !synced {
+			return queueSyncResult{}, fmt.Errorf("%s are not cached yet", gvr.Resource)
+		}
+		for i := range items {
+			obj := queues.Object{GVR: gvr, Object: &items[i]}
+			existing[obj.Key()] = obj
+		}
+	}
+
+	result := queueSyncResult{drifted: []string{}, conflicts: []string{}}
+	// Nothing is declared and nothing is left to delete.
+	if len(desired) == 0 && len(existing) == 0 {
+		return result, nil
+	}
+	for _, obj := range desired {
+		key := obj.Key()
+		live, found := existing[key]
+		delete(existing, key)
+		client := dynamicClient.Resource(obj.GVR).Namespace(obj.Object.GetNamespace())
+
+		if found && queues.RequiresRecreate(obj.Object, live.Object) {
+			klog.Infof("Recreating %s", key)
+			if err := client.Delete(ctx, obj.Object.GetName(), metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+				result.errs = append(result.errs, fmt.Errorf("failed to delete %s: %w", key, err))
+				continue
+			}
+			found = false
+		}
+		if !found {
+			_, err := client.Create(ctx, obj.Object, metav1.CreateOptions{FieldManager: "kueue-operator"})
+			switch {
+			case errors.IsAlreadyExists(err):
+				// The cache may not have seen a queue created by the previous sync yet.
+				if live, err := client.Get(ctx, obj.Object.GetName(), metav1.GetOptions{}); err == nil && labelSelector.Matches(labels.Set(live.GetLabels())) {
+					continue
+				}
+				result.conflicts = append(result.conflicts, key)
+			case err != nil:
+				result.errs = append(result.errs, fmt.Errorf("failed to create %s: %w", key, err))
+			default:
+				klog.Infof("Created %s", key)
+			}
+			continue
+		}
+		outdated := queues.IsOutdated(obj.Object, live.Object)
+		if !outdated && !queues.HasDrifted(obj.Object, live.Object) {
+			continue
+		}
+		if !outdated {
+			result.drifted = append(result.drifted, key)
+		}
+		if _, err := client.Update(ctx, queues.Merge(obj.Object, live.Object), metav1.UpdateOptions{FieldManager: "kueue-operator"}); err != nil {
+			result.errs = append(result.errs, fmt.Errorf("failed to update %s: %w", key, err))
+		} else {
+			klog.Infof("Updated %s", key)
+		}
+	}
+
+	// The remaining queues are no longer desired.
+	for _, gvr := range slices.Backward(gvrs) {
+		for key, stale := range existing {
+			if stale.GVR != gvr {
+				continue
+			}
+			klog.Infof("Deleting %s", key)
+			err := retry.OnError(retry.DefaultBackoff, errors.IsTooManyRequests, func() error {
+				return dynamicClient.Resource(gvr).Namespace(stale.Object.GetNamespace()).Delete(ctx, stale.Object.GetName(), metav1.DeleteOptions{})
+			})
+			if err != nil && !errors.IsNotFound(err) {
+				result.errs = append(result.errs, fmt.Errorf("failed to delete %s: %w", key, err))
+			}
+		}
+	}
+	return result, nil
+}
+
+// manageQueues creates the queues declared in spec.queues, corrects the ones that drifted
+// and deletes the ones that are no longer declared. Queues that exist without the
+// operator's managed-by label were created by users, they are left unchanged and reported
+// as conflicts.
+// Kueue validates queues through its webhooks, so nothing is done until the Kueue
+// deployment has ready replicas and the queues installed with its CRDs are cached.
+func (c *TargetConfigReconciler) manageQueues(ctx context.Context, kueue *kueuev1.Kueue, deployment *appsv1.Deployment) (*applyoperatorv1.OperatorConditionApplyConfiguration, error) {
+	if deployment.Status.ReadyReplicas == 0 || !queueInformersSynced(c.queueInformers, queueGVRs) {
+		return applyoperatorv1.OperatorCondition().
+			WithType(queuesInSyncConditionType).
+			WithStatus(operatorv1.ConditionFalse).
+			WithReason("WaitingForKueue").
+			WithMessage("Queues are created once the Kueue deployment is available"), nil
+	}
+
+	namespaces, err := listManagedNamespaces(c.kubeInformer.Core().V1().Namespaces().Lister(), c.operatorNamespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list managed namespaces: %w", err)
+	}
+	namespaceNames := make([]string, 0, len(namespaces))
+	for _, ns := range namespaces {
+		namespaceNames = append(namespaceNames, ns.Name)
+	}
+	desired, err := queues.Build(kueue.Spec.Queues, namespaceNames)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build queues: %w", err)
+	}
+
+	result, err := syncQueueObjects(ctx, c.dynamicClient, c.queueInformers, queueGVRs, queues.ManagedBySelector(), desired)
+	if err != nil {
+		return nil, err
+	}
+	if len(result.drifted) > 0 {
+		c.eventRecorder.Warningf("QueueDriftCorrected", "queues modified outside of the Kueue operator were reset: %s", strings.Join(result.drifted, ", "))
+	}
+	if len(result.conflicts) > 0 {
+		message := fmt.Sprintf("queues exist but were not created by the Kueue operator, they are left unchanged: %s", strings.Join(result.conflicts, ", "))
+		if previous := v1helpers.FindOperatorCondition(kueue.Status.Conditions, queuesInSyncConditionType); previous == nil || previous.Message != message {
+			klog.Warning(message)
+			c.eventRecorder.Warningf("QueueConflict", "%s", message)
+		}
+		return applyoperatorv1.OperatorCondition().
+			WithType(queuesInSyncConditionType).
+			WithStatus(operatorv1.ConditionFalse).
+			WithReason("Conflict").
+			WithMessage(message), utilerror.NewAggregate(result.errs)
+	}
+	if len(result.errs) > 0 {
+		return applyoperatorv1.OperatorCondition().
+			WithType(queuesInSyncConditionType).
+			WithStatus(operatorv1.ConditionFalse).
+			WithReason("ApplyFailed").
+			WithMessage(utilerror.NewAggregate(result.errs).Error()), utilerror.NewAggregate(result.errs)
+	}
+	if len(result.drifted) > 0 {
+		return applyoperatorv1.OperatorCondition().
+			WithType(queuesInSyncConditionType).
+			WithStatus(operatorv1.ConditionTrue).
+			WithReason("DriftCorrected").
+			WithMessage(fmt.Sprintf("queues modified outside of the Kueue operator were reset: %s", strings.Join(result.drifted, ", "))), nil
+	}
+	return applyoperatorv1.OperatorCondition().
+		WithType(queuesInSyncConditionType).
+		WithStatus(operatorv1.ConditionTrue).
+		WithReason("AsExpected").
+		WithMessage(fmt.Sprintf("%d queues in sync", len(desired))), nil
+}
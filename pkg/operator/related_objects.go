@@ -0,0 +1,222 @@
+package operator
+
+import (
+	"cmp"
+	"path/filepath"
+	"slices"
+
+	"github.com/openshift/kueue-operator/bindata"
+	kueuev1 "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+	applyconfigurationkueueoperatorv1 "github.com/openshift/kueue-operator/pkg/generated/applyconfiguration/kueueoperator/v1"
+	"github.com/openshift/kueue-operator/pkg/limitrange"
+	"github.com/openshift/kueue-operator/pkg/operator/operatorclient"
+	"github.com/openshift/kueue-operator/pkg/queues"
+	"github.com/openshift/library-go/pkg/operator/resource/resourceread"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+)
+
+// maxRelatedObjects is the maximum number of related objects of the Kueue status.
+const maxRelatedObjects = 512
+
+// relatedObjects returns the objects managed by the operator, reported in the Kueue
+// status. The objects of the operator namespace are covered by the namespace itself.
+func (c *TargetConfigReconciler) relatedObjects() ([]kueuev1.RelatedObject, error) {
+	objects := []kueuev1.RelatedObject{
+		{Group: "", Resource: "namespaces", Name: c.operatorNamespace},
+		{Group: "kueue.openshift.io", Resource: "kueues", Name: operatorclient.OperatorConfigName},
+	}
+	add := func(gr schema.GroupResource, namespace, name string) {
+		if namespace == c.operatorNamespace {
+			return
+		}
+		objects = append(objects, kueuev1.RelatedObject{Group: gr.Group, Resource: gr.Resource, Namespace: namespace, Name: name})
+	}
+
+	crds, err := c.managedCRDInformer.Apiextensions().V1().CustomResourceDefinitions().Lister().List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, crd := range crds {
+		if crd.Spec.Group == "kueue.x-k8s.io" {
+			add(schema.GroupResource{Group: "apiextensions.k8s.io", Resource: "customresourcedefinitions"}, "", crd.Name)
+		}
+	}
+	for _, assetPath := range apiServiceFiles {
+		apiService := resourceread.ReadAPIServiceOrDie(bindata.MustAsset(assetPath))
+		add(schema.GroupResource{Group: "apiregistration.k8s.io", Resource: "apiservices"}, "", apiService.Name)
+	}
+
+	// The other objects are labeled as managed by the operator.
+	clusterRoles, err := c.managedInformer.Rbac().V1().ClusterRoles().Lister().List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range clusterRoles {
+		add(schema.GroupResource{Group: "rbac.authorization.k8s.io", Resource: "clusterroles"}, "", obj.Name)
+	}
+	clusterRoleBindings, err := c.managedInformer.Rbac().V1().ClusterRoleBindings().Lister().List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range clusterRoleBindings {
+		add(schema.GroupResource{Group: "rbac.authorization.k8s.io", Resource: "clusterrolebindings"}, "", obj.Name)
+	}
+	roles, err := c.managedInformer.Rbac().V1().Roles().Lister().List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range roles {
+		add(schema.GroupResource{Group: "rbac.authorization.k8s.io", Resource: "roles"}, obj.Namespace, obj.Name)
+	}
+	roleBindings, err := c.managedInformer.Rbac().V1().RoleBindings().Lister().List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range roleBindings {
+		add(schema.GroupResource{Group: "rbac.authorization.k8s.io", Resource: "rolebindings"}, obj.Namespace, obj.Name)
+	}
+	priorityLevelConfigurations, err := c.managedInformer.Flowcontrol().V1().PriorityLevelConfigurations().Lister().List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range priorityLevelConfigurations {
+		add(schema.GroupResource{Group: "flowcontrol.apiserver.k8s.io", Resource: "prioritylevelconfigurations"}, "", obj.Name)
+	}
+	flowSchemas, err := c.managedInformer.Flowcontrol().V1().FlowSchemas().Lister().List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range flowSchemas {
+		add(schema.GroupResource{Group: "flowcontrol.apiserver.k8s.io", Resource: "flowschemas"}, "", obj.Name)
+	}
+	mutatingWebhooks, err := c.managedInformer.Admissionregistration().V1().MutatingWebhookConfigurations().Lister().List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range mutatingWebhooks {
+		add(schema.GroupResource{Group: "admissionregistration.k8s.io", Resource: "mutatingwebhookconfigurations"}, "", obj.Name)
+	}
+	validatingWebhooks, err := c.managedInformer.Admissionregistration().V1().ValidatingWebhookConfigurations().Lister().List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range validatingWebhooks {
+		add(schema.GroupResource{Group: "admissionregistration.k8s.io", Resource: "validatingwebhookconfigurations"}, "", obj.Name)
+	}
+	validatingAdmissionPolicies, err := c.managedInformer.Admissionregistration().V1().ValidatingAdmissionPolicies().Lister().List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range validatingAdmissionPolicies {
+		add(schema.GroupResource{Group: "admissionregistration.k8s.io", Resource: "validatingadmissionpolicies"}, "", obj.Name)
+	}
+	validatingAdmissionPolicyBindings, err := c.managedInformer.Admissionregistration().V1().ValidatingAdmissionPolicyBindings().Lister().List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range validatingAdmissionPolicyBindings {
+		add(schema.GroupResource{Group: "admissionregistration.k8s.io", Resource: "validatingadmissionpolicybindings"}, "", obj.Name)
+	}
+	if c.mapSupported {
+		mutatingAdmissionPolicies, err := c.managedInformer.Admissionregistration().V1beta1().MutatingAdmissionPolicies().Lister().List(labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range mutatingAdmissionPolicies {
+			add(schema.GroupResource{Group: "admissionregistration.k8s.io", Resource: "mutatingadmissionpolicies"}, "", obj.Name)
+		}
+		mutatingAdmissionPolicyBindings, err := c.managedInformer.Admissionregistration().V1beta1().MutatingAdmissionPolicyBindings().Lister().List(labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range mutatingAdmissionPolicyBindings {
+			add(schema.GroupResource{Group: "admissionregistration.k8s.io", Resource: "mutatingadmissionpolicybindings"}, "", obj.Name)
+		}
+	}
+
+	limitRangeSelector, err := labels.Parse(limitrange.Selector())
+	if err != nil {
+		return nil, err
+	}
+	limitRanges, err := c.kubeInformer.Core().V1().LimitRanges().Lister().List(limitRangeSelector)
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range limitRanges {
+		add(schema.GroupResource{Resource: "limitranges"}, obj.Namespace, obj.Name)
+	}
+
+	// The queues are reported once their informers are synced.
+	queueSelector, err := labels.Parse(queues.ManagedBySelector())
+	if err != nil {
+		return nil, err
+	}
+	for _, gvr := range []schema.GroupVersionResource{queues.ResourceFlavorsGVR, queues.CohortsGVR, queues.ClusterQueuesGVR} {
+		objs, _, err := listCachedQueues(c.queueInformers, gvr, queueSelector)
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range objs {
+			add(gvr.GroupResource(), "", obj.GetName())
+		}
+	}
+
+	if c.isOpenShift {
+		files, err := bindata.AssetDir(dashboardDir)
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			dashboard := resourceread.ReadConfigMapV1OrDie(bindata.MustAsset(filepath.Join(dashboardDir, file)))
+			add(schema.GroupResource{Resource: "configmaps"}, dashboard.Namespace, dashboard.Name)
+		}
+	}
+
+	slices.SortFunc(objects[2:], func(a, b kueuev1.RelatedObject) int {
+		return cmp.Or(
+			cmp.Compare(a.Group, b.Group),
+			cmp.Compare(a.Resource, b.Resource),
+			cmp.Compare(a.Namespace, b.Namespace),
+			cmp.Compare(a.Name, b.Name),
+		)
+	})
+	// The LimitRanges of many managed namespaces may exceed the limit of the status.
+	if len(objects) > maxRelatedObjects {
+		klog.V(2).Infof("reporting %d of the %d related objects", maxRelatedObjects, len(objects))
+		objects = objects[:maxRelatedObjects]
+	}
+	return objects, nil
+}
+
+func relatedObjectApplyConfigurations(objects []kueuev1.RelatedObject) []*applyconfigurationkueueoperatorv1.RelatedObjectApplyConfiguration {
+	configurations := make([]*applyconfigurationkueueoperatorv1.RelatedObjectApplyConfiguration, 0, len(objects))
+	for _, object := range objects {
+		configuration := applyconfigurationkueueoperatorv1.RelatedObject().
+			WithGroup(object.Group).
+			WithResource(object.Resource).
+			WithName(object.Name)
+		if object.Namespace != "" {
+			configuration.WithNamespace(object.Namespace)
+		}
+		configurations = append(configurations, configuration)
+	}
+	return configurations
+}
+
+func effectiveConfigurationApplyConfiguration(effective kueuev1.EffectiveConfiguration) *applyconfigurationkueueoperatorv1.EffectiveConfigurationApplyConfiguration {
+	configuration := applyconfigurationkueueoperatorv1.EffectiveConfiguration().
+		WithGangScheduling(effective.GangScheduling).
+		WithPreemptionPolicy(effective.PreemptionPolicy).
+		WithLabelPolicy(effective.LabelPolicy).
+		WithIntegrations(effective.Integrations...).
+		WithFeatureGates(effective.FeatureGates...)
+	if effective.GangSchedulingAdmission != "" {
+		configuration.WithGangSchedulingAdmission(effective.GangSchedulingAdmission)
+	}
+	if effective.ConfigHash != "" {
+		configuration.WithConfigHash(effective.ConfigHash)
+	}
+	return configuration
+}
@@ -9,32 +9,34 @@ import (
 	configclient "github.com/openshift/client-go/config/clientset/versioned"
 	openshiftrouteclientset "github.com/openshift/client-go/route/clientset/versioned"
 	jobsetoperatorconfigclient "github.com/openshift/jobset-operator/pkg/generated/clientset/versioned"
+	kueuev1 "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
 	operatorconfigclient "github.com/openshift/kueue-operator/pkg/generated/clientset/versioned"
 	operatorclientinformers "github.com/openshift/kueue-operator/pkg/generated/informers/externalversions"
+	"github.com/openshift/kueue-operator/pkg/namespace"
 	"github.com/openshift/kueue-operator/pkg/operator/operatorclient"
 	"github.com/openshift/library-go/pkg/controller/controllercmd"
 	"github.com/openshift/library-go/pkg/operator/loglevel"
 	"github.com/openshift/library-go/pkg/operator/v1helpers"
 	lwsoperatorconfigclient "github.com/openshift/lws-operator/pkg/generated/clientset/versioned"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apiextclientsetv1 "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	apiextv1 "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/typed/apiextensions/v1"
 	apiextinformer "k8s.io/apiextensions-apiserver/pkg/client/informers/externalversions"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/informers"
+	resourcev1informers "k8s.io/client-go/informers/resource/v1"
 	"k8s.io/kube-aggregator/pkg/client/clientset_generated/clientset"
 	apiregistrationv1client "k8s.io/kube-aggregator/pkg/client/clientset_generated/clientset/typed/apiregistration/v1"
 	apiregistrationinformers "k8s.io/kube-aggregator/pkg/client/informers/externalversions"
 
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
 )
 
-type queueItem struct {
-	kind string
-	name string
-}
-
 // stripStatusTransform removes the status field from objects to prevent
 // status-only changes from triggering reconciliation loops.
 // This is critical for resources like APIService, FlowSchema, and
@@ -63,6 +65,32 @@ func stripStatusTransform(obj interface{}) (interface{}, error) {
 	return obj, nil
 }
 
+// stripCustomResourceDefinitionSchemaTransform removes the status and the validation
+// schemas of the CustomResourceDefinitions, which account for most of their size. The
+// CRD informer is not filtered on the managed-by label, the CRDs of cert-manager and of
+// the integrations are looked up to check the dependencies of Kueue, and only need their
+// names and served versions.
+func stripCustomResourceDefinitionSchemaTransform(obj interface{}) (interface{}, error) {
+	obj, err := stripStatusTransform(obj)
+	if err != nil {
+		return obj, err
+	}
+	crd, ok := obj.(*apiextensionsv1.CustomResourceDefinition)
+	if !ok {
+		return obj, nil
+	}
+	for i := range crd.Spec.Versions {
+		crd.Spec.Versions[i].Schema = nil
+	}
+	return crd, nil
+}
+
+// managedByListOptions restricts the informers of the cluster-wide objects applied by the
+// operator to the ones labeled with the managed-by label.
+func managedByListOptions(options *metav1.ListOptions) {
+	options.LabelSelector = kueuev1.ManagedByLabel + "=" + kueuev1.ManagedByValue
+}
+
 func RunOperator(ctx context.Context, cc *controllercmd.ControllerContext) error {
 	kubeClient, err := kubernetes.NewForConfig(cc.ProtoKubeConfig)
 	if err != nil {
@@ -127,14 +155,23 @@ func RunOperator(ctx context.Context, cc *controllercmd.ControllerContext) error
 
 	// Apply status strip transform to prevent status-only changes from triggering reconciliation
 	crdInformer := apiextinformer.NewSharedInformerFactoryWithOptions(
+		crdClientSet,
+		10*time.Minute,
+		apiextinformer.WithTransform(stripCustomResourceDefinitionSchemaTransform),
+	)
+	// The Kueue CRDs applied by the operator are cached with their schemas, which the
+	// apply compares with the required ones.
+	managedCRDInformer := apiextinformer.NewSharedInformerFactoryWithOptions(
 		crdClientSet,
 		10*time.Minute,
 		apiextinformer.WithTransform(stripStatusTransform),
+		apiextinformer.WithTweakListOptions(managedByListOptions),
 	)
 	apiregistrationInformer := apiregistrationinformers.NewSharedInformerFactoryWithOptions(
 		clientset.NewForConfigOrDie(cc.KubeConfig),
 		5*time.Minute,
 		apiregistrationinformers.WithTransform(stripStatusTransform),
+		apiregistrationinformers.WithTweakListOptions(managedByListOptions),
 	)
 
 	kubeInformer := informers.NewSharedInformerFactoryWithOptions(
@@ -142,6 +179,28 @@ func RunOperator(ctx context.Context, cc *controllercmd.ControllerContext) error
 		5*time.Minute,
 		informers.WithTransform(stripStatusTransform),
 	)
+	// The RBAC, flow control and webhook objects of the cluster are not all cached, only
+	// the ones applied by the operator.
+	managedInformer := informers.NewSharedInformerFactoryWithOptions(
+		kubeClient,
+		5*time.Minute,
+		informers.WithTransform(stripStatusTransform),
+		informers.WithTweakListOptions(managedByListOptions),
+	)
+
+	queueInformers := newQueueInformers(dynamicClient)
+
+	// DeviceClasses are watched when the DRA APIs are served. On a cluster upgraded to
+	// serve them, the reconciler starts watching them from its next full sync.
+	draSupported, err := isResourceRegistered(discoveryClient, deviceClassGVK)
+	if err != nil {
+		return err
+	}
+	var deviceClassInformer resourcev1informers.DeviceClassInformer
+	if draSupported {
+		klog.Infof("DRA APIs (resource.k8s.io/v1) are available, watching DeviceClasses")
+		deviceClassInformer = kubeInformer.Resource().V1().DeviceClasses()
+	}
 
 	targetConfigReconciler, err := NewTargetConfigReconciler(
 		ctx,
@@ -158,8 +217,12 @@ func RunOperator(ctx context.Context, cc *controllercmd.ControllerContext) error
 		crdClient,
 		apiRegistrationClient,
 		crdInformer,
+		managedCRDInformer,
 		apiregistrationInformer,
 		kubeInformer,
+		managedInformer,
+		queueInformers,
+		deviceClassInformer,
 		openshiftConfigClient,
 		cc.EventRecorder,
 		os.Getenv("RELATED_IMAGE_OPERAND_IMAGE"),
@@ -168,19 +231,108 @@ func RunOperator(ctx context.Context, cc *controllercmd.ControllerContext) error
 		return err
 	}
 
+	namespaceOnboardingController := NewNamespaceOnboardingController(
+		operatorConfigClient.KueueV1(),
+		kueueClient,
+		dynamicClient,
+		queueInformers,
+		kubeInformersForNamespaces,
+		kubeInformer.Core().V1().Namespaces(),
+		namespace.GetNamespace(),
+		cc.EventRecorder,
+	)
+
+	containerDefaultsController := NewContainerDefaultsController(
+		operatorConfigClient.KueueV1(),
+		kueueClient,
+		kubeClient,
+		kubeInformer.Core().V1().Namespaces(),
+		kubeInformer.Core().V1().LimitRanges(),
+		namespace.GetNamespace(),
+		cc.EventRecorder,
+	)
+
+	admittedWorkloadsController := NewAdmittedWorkloadsController(
+		kueueClient,
+		kubeClient,
+		queueInformers,
+		kubeInformer.Core().V1().Namespaces(),
+		cc.EventRecorder,
+	)
+
+	integrationRemovalController := NewIntegrationRemovalController(
+		operatorConfigClient.KueueV1(),
+		kueueClient,
+		kubeClient,
+		dynamicClient,
+		kubeInformersForNamespaces,
+		namespace.GetNamespace(),
+		cc.EventRecorder,
+	)
+
+	labelPolicyPreviewController := NewLabelPolicyPreviewController(
+		operatorConfigClient.KueueV1(),
+		kueueClient,
+		dynamicClient,
+		kubeInformersForNamespaces,
+		kubeInformer.Core().V1().Namespaces(),
+		namespace.GetNamespace(),
+		cc.EventRecorder,
+	)
+
+	uninstallPreflightController := NewUninstallPreflightController(
+		operatorConfigClient.KueueV1(),
+		kueueClient,
+		queueInformers,
+		cc.EventRecorder,
+	)
+
+	// On OpenShift, the webhook server of the operator follows the TLS profile of the
+	// cluster.
+	var apiServerLister cache.GenericLister
+	isOpenShift, err := isResourceRegistered(discoveryClient, apiServerGVR.GroupVersion().WithKind("APIServer"))
+	if err != nil {
+		return err
+	}
+	if isOpenShift {
+		configInformer := dynamicinformer.NewDynamicSharedInformerFactory(dynamicClient, 10*time.Minute)
+		apiServerLister = configInformer.ForResource(apiServerGVR).Lister()
+		configInformer.Start(ctx.Done())
+	}
+
+	secretLister := kubeInformersForNamespaces.InformersFor(namespace.GetNamespace()).Core().V1().Secrets().Lister()
+	namespaceLister := kubeInformer.Core().V1().Namespaces().Lister()
+	kueueLister := operatorConfigInformers.Kueue().V1().Kueues().Lister()
+
 	logLevelController := loglevel.NewClusterOperatorLoggingController(kueueClient, cc.EventRecorder)
 
 	klog.Infof("Starting informers")
 	operatorConfigInformers.Start(ctx.Done())
 	kubeInformersForNamespaces.Start(ctx.Done())
 	crdInformer.Start(ctx.Done())
+	managedCRDInformer.Start(ctx.Done())
 	apiregistrationInformer.Start(ctx.Done())
 	kubeInformer.Start(ctx.Done())
+	managedInformer.Start(ctx.Done())
+	queueInformers.Start(ctx.Done())
 
 	klog.Infof("Starting log level controller")
 	go logLevelController.Run(ctx, 1)
 	klog.Infof("Starting target config reconciler")
 	go targetConfigReconciler.Run(ctx, 1)
+	klog.Infof("Starting namespace onboarding controller")
+	go namespaceOnboardingController.Run(ctx, 1)
+	klog.Infof("Starting container defaults controller")
+	go containerDefaultsController.Run(ctx, 1)
+	klog.Infof("Starting admitted workloads controller")
+	go admittedWorkloadsController.Run(ctx, 1)
+	go uninstallPreflightController.Run(ctx, 1)
+	go integrationRemovalController.Run(ctx, 1)
+	go labelPolicyPreviewController.Run(ctx, 1)
+	klog.Infof("Starting webhook server")
+	if err := startWebhookServer(ctx, kubeClient, secretLister, apiServerLister, namespaceLister, kueueLister, namespace.GetNamespace()); err != nil {
+		return err
+	}
 
 	<-ctx.Done()
 	return nil
@@ -1,6 +1,7 @@
 package operator
 
 import (
+	"cmp"
 	"context"
 	"crypto/sha256"
 	"encoding/json"
@@ -9,6 +10,7 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	operatorv1 "github.com/openshift/api/operator/v1"
@@ -19,14 +21,19 @@ import (
 	"github.com/openshift/kueue-operator/bindata"
 	kueuev1 "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
 	"github.com/openshift/kueue-operator/pkg/cert"
+	kueueconditions "github.com/openshift/kueue-operator/pkg/conditions"
 	"github.com/openshift/kueue-operator/pkg/configmap"
 	applyconfigurationkueueoperatorv1 "github.com/openshift/kueue-operator/pkg/generated/applyconfiguration/kueueoperator/v1"
 	kueueconfigclient "github.com/openshift/kueue-operator/pkg/generated/clientset/versioned/typed/kueueoperator/v1"
 	operatorclientinformers "github.com/openshift/kueue-operator/pkg/generated/informers/externalversions/kueueoperator/v1"
+	"github.com/openshift/kueue-operator/pkg/integration"
+	"github.com/openshift/kueue-operator/pkg/metrics"
 	"github.com/openshift/kueue-operator/pkg/namespace"
 	"github.com/openshift/kueue-operator/pkg/operator/operatorclient"
-	"github.com/openshift/kueue-operator/pkg/tlsprofile"
+	"github.com/openshift/kueue-operator/pkg/prometheusrule"
+	"github.com/openshift/kueue-operator/pkg/rbac"
 	utilresourceapply "github.com/openshift/kueue-operator/pkg/util/resourceapply"
+	"github.com/openshift/kueue-operator/pkg/version"
 	"github.com/openshift/kueue-operator/pkg/webhook"
 	"github.com/openshift/library-go/pkg/controller/factory"
 	"github.com/openshift/library-go/pkg/operator/events"
@@ -61,15 +68,15 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	utilerror "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/informers"
+	resourcev1informers "k8s.io/client-go/informers/resource/v1"
 	"k8s.io/client-go/kubernetes"
-	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/retry"
-	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/clock"
 )
@@ -89,20 +96,55 @@ type TargetConfigReconciler struct {
 	dynamicClient              dynamic.Interface
 	discoveryClient            discovery.DiscoveryInterface
 	eventRecorder              events.Recorder
-	queue                      workqueue.TypedRateLimitingInterface[queueItem]
 	kubeInformersForNamespaces v1helpers.KubeInformersForNamespaces
 	crdClient                  apiextv1.ApiextensionsV1Interface
-	crdInformer                apiextinformer.SharedInformerFactory
-	kubeInformer               informers.SharedInformerFactory
-	operatorNamespace          string
-	resourceCache              resourceapply.ResourceCache
-	kueueImage                 string
-	serviceMonitorSupport      bool
-	apiRegistrationClient      apiregistrationv1client.ApiregistrationV1Interface
-	openshiftConfigClient      configclient.Interface
-	configInformer             dynamicinformer.DynamicSharedInformerFactory
-	isOpenShift                bool
-	draSupported               bool
+	// crdInformer caches the CRDs of the cluster without their schemas, to check the
+	// dependencies of Kueue. managedCRDInformer caches the Kueue CRDs applied by the
+	// operator.
+	crdInformer        apiextinformer.SharedInformerFactory
+	managedCRDInformer apiextinformer.SharedInformerFactory
+	kubeInformer       informers.SharedInformerFactory
+	managedInformer    informers.SharedInformerFactory
+	queueInformers     dynamicinformer.DynamicSharedInformerFactory
+	operatorNamespace  string
+	resourceCache      resourceapply.ResourceCache
+	kueueImage         string
+	// kueueVersion is the Kueue release deployed by the operator, reported in the Kueue
+	// status.
+	kueueVersion          string
+	serviceMonitorSupport bool
+	apiRegistrationClient apiregistrationv1client.ApiregistrationV1Interface
+	openshiftConfigClient configclient.Interface
+	configInformer        dynamicinformer.DynamicSharedInformerFactory
+	isOpenShift           bool
+	draSupported          bool
+	// mapSupported is whether MutatingAdmissionPolicies are served, it is checked when
+	// the operator starts.
+	mapSupported bool
+	// deviceClassInformer is nil when the DRA APIs are not served.
+	deviceClassInformer resourcev1informers.DeviceClassInformer
+	// monitoringInformer caches the ServiceMonitors of the operator namespace, it is nil
+	// when the Prometheus operator is not installed.
+	monitoringInformer  dynamicinformer.DynamicSharedInformerFactory
+	integrationStatuses []kueuev1.IntegrationStatus
+	// discoveredDeviceClassMappings are the DeviceClass mappings found by auto discovery,
+	// reported in the Kueue status.
+	discoveredDeviceClassMappings []kueuev1.DeviceClassMapping
+	// subControllerResults are reused by the syncs targeting other sub-controllers.
+	subControllerResults map[string]subControllerResult
+	// applySlots bounds the apply steps running concurrently.
+	applySlots chan struct{}
+	// legacyRBACLookups are the RBAC objects already looked up directly, see
+	// lookUpLegacyRBAC.
+	legacyRBACLookups     sets.Set[string]
+	legacyRBACLookupsLock sync.Mutex
+}
+
+// apiServerGVR is the APIServer of OpenShift, which holds the TLS profile of the cluster.
+var apiServerGVR = schema.GroupVersionResource{
+	Group:    "config.openshift.io",
+	Version:  "v1",
+	Resource: "apiservers",
 }
 
 // computeSpecHash computes a SHA256 hash of the given object's spec.
@@ -117,6 +159,18 @@ func computeSpecHash(obj interface{}) (string, error) {
 	return fmt.Sprintf("%x", hash), nil
 }
 
+// setManagedByLabel labels an object applied by the operator so that it is cached by the
+// informers filtered on the managed-by label. The objects applied by earlier versions of
+// the operator are labeled by their next apply.
+func setManagedByLabel(obj metav1.Object) {
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[kueuev1.ManagedByLabel] = kueuev1.ManagedByValue
+	obj.SetLabels(labels)
+}
+
 func NewTargetConfigReconciler(
 	ctx context.Context,
 	operatorConfigClient kueueconfigclient.KueueV1Interface,
@@ -132,8 +186,12 @@ func NewTargetConfigReconciler(
 	crdClient apiextv1.ApiextensionsV1Interface,
 	apiRegistrationClient apiregistrationv1client.ApiregistrationV1Interface,
 	crdInformer apiextinformer.SharedInformerFactory,
+	managedCRDInformer apiextinformer.SharedInformerFactory,
 	apiregistrationInformer apiregistrationinformers.SharedInformerFactory,
 	kubeInformer informers.SharedInformerFactory,
+	managedInformer informers.SharedInformerFactory,
+	queueInformers dynamicinformer.DynamicSharedInformerFactory,
+	deviceClassInformer resourcev1informers.DeviceClassInformer,
 	openshiftConfigClient configclient.Interface,
 	eventRecorder events.Recorder,
 	kueueImage string,
@@ -148,48 +206,29 @@ func NewTargetConfigReconciler(
 		dynamicClient:              dynamicClient,
 		discoveryClient:            discoveryClient,
 		eventRecorder:              eventRecorder,
-		queue:                      workqueue.NewTypedRateLimitingQueueWithConfig(workqueue.DefaultTypedControllerRateLimiter[queueItem](), workqueue.TypedRateLimitingQueueConfig[queueItem]{Name: "TargetConfigReconciler"}),
 		kubeInformersForNamespaces: kubeInformersForNamespaces,
 		crdClient:                  crdClient,
 		crdInformer:                crdInformer,
+		managedCRDInformer:         managedCRDInformer,
 		kubeInformer:               kubeInformer,
+		managedInformer:            managedInformer,
+		queueInformers:             queueInformers,
+		deviceClassInformer:        deviceClassInformer,
+		draSupported:               deviceClassInformer != nil,
 		operatorNamespace:          namespace.GetNamespace(),
-		resourceCache:              resourceapply.NewResourceCache(),
+		resourceCache:              newInstrumentedResourceCache(resourceapply.NewResourceCache()),
 		kueueImage:                 kueueImage,
+		kueueVersion:               cmp.Or(version.KueueVersion(), integration.KueueVersion),
 		serviceMonitorSupport:      false,
 		apiRegistrationClient:      apiRegistrationClient,
 		openshiftConfigClient:      openshiftConfigClient,
-	}
-
-	_, err := operatorClientInformer.Informer().AddEventHandler(c.eventHandler(queueItem{kind: "kueue"}))
-	if err != nil {
-		return nil, err
-	}
-
-	_, err = kubeInformersForNamespaces.InformersFor(c.operatorNamespace).Core().V1().ConfigMaps().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
-		AddFunc: func(obj interface{}) {},
-		UpdateFunc: func(old, new interface{}) {
-			cm, ok := old.(*v1.ConfigMap)
-			if !ok {
-				klog.Errorf("Unable to convert obj to ConfigMap")
-				return
-			}
-			c.queue.Add(queueItem{kind: "configmap", name: cm.Name})
-		},
-		DeleteFunc: func(obj interface{}) {
-			cm, ok := obj.(*v1.ConfigMap)
-			if !ok {
-				klog.Errorf("Unable to convert obj to ConfigMap")
-				return
-			}
-			c.queue.Add(queueItem{kind: "configmap", name: cm.Name})
-		},
-	})
-	if err != nil {
-		return nil, err
+		subControllerResults:       map[string]subControllerResult{},
+		applySlots:                 make(chan struct{}, maxConcurrentApplies),
+		legacyRBACLookups:          sets.New[string](),
 	}
 
 	// check for ServiceMonitor support
+	var err error
 	c.serviceMonitorSupport, err = isResourceRegistered(c.discoveryClient, schema.GroupVersionKind{
 		Kind:    "ServiceMonitor",
 		Group:   "monitoring.coreos.com",
@@ -200,52 +239,101 @@ func NewTargetConfigReconciler(
 		return nil, err
 	}
 
+	c.mapSupported, err = isResourceRegistered(c.discoveryClient, mutatingAdmissionPolicyGVK)
+	if err != nil {
+		klog.Errorf("unable to check if MutatingAdmissionPolicies are supported: %v", err)
+		return nil, err
+	}
+
 	// Detect platform type (OpenShift vs kind/vanilla k8s)
 	c.isOpenShift = c.detectOpenShift()
 
-	informerList := []factory.Informer{
-		kueueClient.Informer(),
-		kubeInformersForNamespaces.InformersFor(c.operatorNamespace).Apps().V1().Deployments().Informer(),
-		// RBAC informers for caching
-		c.kubeInformer.Rbac().V1().ClusterRoles().Informer(),
-		c.kubeInformer.Rbac().V1().ClusterRoleBindings().Informer(),
-		c.kubeInformer.Rbac().V1().Roles().Informer(),
-		c.kubeInformer.Rbac().V1().RoleBindings().Informer(),
-		// CRD informer for caching
-		c.crdInformer.Apiextensions().V1().CustomResourceDefinitions().Informer(),
-		// Webhook configuration informers for caching
-		kubeInformersForNamespaces.InformersFor(c.operatorNamespace).Admissionregistration().V1().MutatingWebhookConfigurations().Informer(),
-		kubeInformersForNamespaces.InformersFor(c.operatorNamespace).Admissionregistration().V1().ValidatingWebhookConfigurations().Informer(),
-		// Flow control informers for caching
-		c.kubeInformer.Flowcontrol().V1().PriorityLevelConfigurations().Informer(),
-		// Namespaced resource informers for caching
-		kubeInformersForNamespaces.InformersFor(c.operatorNamespace).Core().V1().ConfigMaps().Informer(),
-		kubeInformersForNamespaces.InformersFor(c.operatorNamespace).Core().V1().Secrets().Informer(),
-		kubeInformersForNamespaces.InformersFor(c.operatorNamespace).Core().V1().Services().Informer(),
-		kubeInformersForNamespaces.InformersFor(c.operatorNamespace).Core().V1().ServiceAccounts().Informer(),
-		kubeInformersForNamespaces.InformersFor(c.operatorNamespace).Networking().V1().NetworkPolicies().Informer(),
-		kubeInformer.Flowcontrol().V1().FlowSchemas().Informer(),
-		apiregistrationInformer.Apiregistration().V1().APIServices().Informer(),
-	}
+	operatorNamespaceInformers := kubeInformersForNamespaces.InformersFor(c.operatorNamespace)
+	controllerFactory := factory.New().
+		WithInformers(kueueClient.Informer()).
+		// The other informers cache the objects applied by the sub-controllers, their
+		// events only sync the sub-controller applying them.
+		WithInformersQueueKeysFunc(subControllerQueueKeys(certificatesSubController),
+			operatorNamespaceInformers.Core().V1().Secrets().Informer(),
+		).
+		WithInformersQueueKeysFunc(subControllerQueueKeys(rbacSubController),
+			c.managedInformer.Rbac().V1().ClusterRoles().Informer(),
+			c.managedInformer.Rbac().V1().ClusterRoleBindings().Informer(),
+			c.managedInformer.Rbac().V1().Roles().Informer(),
+			c.managedInformer.Rbac().V1().RoleBindings().Informer(),
+			operatorNamespaceInformers.Core().V1().ServiceAccounts().Informer(),
+		).
+		WithInformersQueueKeysFunc(subControllerQueueKeys(networkSubController),
+			operatorNamespaceInformers.Core().V1().Services().Informer(),
+			operatorNamespaceInformers.Networking().V1().NetworkPolicies().Informer(),
+			c.managedInformer.Flowcontrol().V1().PriorityLevelConfigurations().Informer(),
+			c.managedInformer.Flowcontrol().V1().FlowSchemas().Informer(),
+			apiregistrationInformer.Apiregistration().V1().APIServices().Informer(),
+		).
+		WithInformersQueueKeysFunc(subControllerQueueKeys(customResourceDefinitionsSubController),
+			c.managedCRDInformer.Apiextensions().V1().CustomResourceDefinitions().Informer(),
+		).
+		WithFilteredEventsInformersQueueKeysFunc(factory.DefaultQueueKeysFunc, isDependencyCustomResourceDefinition,
+			c.crdInformer.Apiextensions().V1().CustomResourceDefinitions().Informer(),
+		).
+		WithInformersQueueKeysFunc(subControllerQueueKeys(webhooksSubController),
+			c.managedInformer.Admissionregistration().V1().MutatingWebhookConfigurations().Informer(),
+			c.managedInformer.Admissionregistration().V1().ValidatingWebhookConfigurations().Informer(),
+		).
+		WithInformersQueueKeysFunc(subControllerQueueKeys(operandSubController),
+			operatorNamespaceInformers.Apps().V1().Deployments().Informer(),
+			operatorNamespaceInformers.Core().V1().ConfigMaps().Informer(),
+		).
+		// Namespace informer to stamp LocalQueues into managed namespaces
+		WithInformersQueueKeysFunc(subControllerQueueKeys(namespacesQueueKey),
+			c.kubeInformer.Core().V1().Namespaces().Informer(),
+		)
 
 	// On OpenShift, watch APIServer CR for TLS profile changes
 	if c.isOpenShift {
-		apiServerGVR := schema.GroupVersionResource{
-			Group:    "config.openshift.io",
-			Version:  "v1",
-			Resource: "apiservers",
-		}
 		c.configInformer = dynamicinformer.NewDynamicSharedInformerFactory(c.dynamicClient, 10*time.Minute)
-		informerList = append(informerList, c.configInformer.ForResource(apiServerGVR).Informer())
+		controllerFactory = controllerFactory.WithInformersQueueKeysFunc(subControllerQueueKeys(operandSubController),
+			c.configInformer.ForResource(apiServerGVR).Informer())
 		c.configInformer.Start(ctx.Done())
 	}
 
-	return factory.New().WithInformers(informerList...).ResyncEvery(5*time.Minute).
+	// The ServiceMonitor of the tenant LocalQueue metrics is only deleted when it is cached.
+	if c.serviceMonitorSupport {
+		c.monitoringInformer = dynamicinformer.NewFilteredDynamicSharedInformerFactory(c.dynamicClient, 10*time.Minute, c.operatorNamespace, nil)
+		controllerFactory = controllerFactory.WithBareInformers(c.monitoringInformer.ForResource(serviceMonitorGVR).Informer())
+		c.monitoringInformer.Start(ctx.Done())
+	}
+
+	// The admission policies are only deleted when they are cached.
+	controllerFactory = controllerFactory.WithBareInformers(
+		c.managedInformer.Admissionregistration().V1().ValidatingAdmissionPolicies().Informer(),
+		c.managedInformer.Admissionregistration().V1().ValidatingAdmissionPolicyBindings().Informer(),
+	)
+	if c.mapSupported {
+		controllerFactory = controllerFactory.WithBareInformers(
+			c.managedInformer.Admissionregistration().V1beta1().MutatingAdmissionPolicies().Informer(),
+			c.managedInformer.Admissionregistration().V1beta1().MutatingAdmissionPolicyBindings().Informer(),
+		)
+	}
+
+	// DeviceClass changes trigger a sync so that missing classes and discovered
+	// mappings are reported without waiting for the periodic resync.
+	if c.deviceClassInformer != nil {
+		controllerFactory = controllerFactory.WithInformers(c.deviceClassInformer.Informer())
+	}
+
+	// The periodic resync syncs all the sub-controllers, as a safety net.
+	return controllerFactory.ResyncEvery(5*time.Minute).
 		WithSync(c.sync).
 		ToController("KueueOperator", c.eventRecorder), nil
 }
 
-func (c *TargetConfigReconciler) sync(ctx context.Context, syncCtx factory.SyncContext) error {
+func (c *TargetConfigReconciler) sync(ctx context.Context, syncCtx factory.SyncContext) (err error) {
+	start := time.Now()
+	defer func() {
+		metrics.ObserveSync(time.Since(start), err)
+	}()
+
 	// Get Kueue from informer cache first so we can update status if needed.
 	obj, exists, err := c.kueueClient.Informer().GetIndexer().GetByKey(operatorclient.OperatorConfigName)
 	if err != nil {
@@ -266,30 +354,38 @@ func (c *TargetConfigReconciler) sync(ctx context.Context, syncCtx factory.SyncC
 		return nil
 	}
 
-	found, err := c.isResourceRegisteredCached(schema.GroupVersionKind{
-		Group:   "cert-manager.io",
-		Version: "v1",
-		Kind:    "Issuer",
-	})
+	missingDependencies := []string{}
+
+	integrationStatuses, err := c.checkIntegrationDependencies(kueue.Spec.Config.Integrations.Frameworks)
 	if err != nil {
-		klog.Errorf("unable to check cert-manager is installed: %v", err)
+		klog.Errorf("unable to check integration dependencies: %v", err)
 		return err
 	}
-	if !found {
-		klog.Errorf("please make sure that cert-manager is installed")
-		c.eventRecorder.Eventf("CertManagerMissing", "cert-manager is not installed")
+	c.recordIntegrationStatusChanges(kueue.Status.Integrations, integrationStatuses)
+	c.integrationStatuses = integrationStatuses
 
-		// Update Kueue CR status with Degraded condition
-		conditions := c.buildCertManagerMissingConditions()
-		if err := c.updateKueueStatus(ctx, kueue, conditions, nil); err != nil {
-			klog.Errorf("failed to update status: %v", err)
+	// kueueConfig is the configuration Kueue is deployed with. Integrations whose APIs are
+	// unavailable are left out of it according to the missing dependency policy, the
+	// remaining unavailable integrations degrade the operator.
+	skippedFrameworks := integration.Skipped(kueue.Spec.Config.Integrations, integrationStatuses)
+	kueueConfig := integration.WithoutFrameworks(kueue.Spec.Config, skippedFrameworks)
+	for _, framework := range integration.Unavailable(integrationStatuses) {
+		if !slices.Contains(skippedFrameworks, framework) {
+			missingDependencies = append(missingDependencies, string(framework))
 		}
-		return nil
 	}
 
-	var dependencyCondition *applyoperatorv1.OperatorConditionApplyConfiguration
-	missingDependencies := []string{}
-	for _, framework := range kueue.Spec.Config.Integrations.Frameworks {
+	// The QueueName label policy is kept while a change to None waits for an acknowledgement.
+	labelPolicyCondition, err := c.manageLabelPolicySwitch(kueue, &kueueConfig)
+	if err != nil {
+		klog.Errorf("unable to manage the label policy switch: %v", err)
+		return err
+	}
+
+	for _, framework := range kueueConfig.Integrations.Frameworks {
+		if slices.Contains(missingDependencies, string(framework)) {
+			continue
+		}
 		if framework == kueuev1.KueueIntegrationJobSet {
 			available, err := c.isOperatorAvailability(ctx, "JobSetOperator", func(ctx context.Context, name string, opts metav1.GetOptions) (interface{}, error) {
 				return c.jobsetOperatorConfigClient.Get(ctx, name, opts)
@@ -315,32 +411,33 @@ func (c *TargetConfigReconciler) sync(ctx context.Context, syncCtx factory.SyncC
 			}
 		}
 	}
-	// Check DRA API availability if deviceClassMappings are configured.
-	// Kueue's DRA integration requires resource.k8s.io/v1 (Kubernetes 1.34+ / OCP 4.21+).
-	// The deviceClassMappings config is preserved in the configmap so it takes effect
-	// automatically after a cluster upgrade, but the DRA feature gate is only enabled
-	// when the APIs are available.
-	c.draSupported = false
-	if len(kueue.Spec.Config.Resources.DeviceClassMappings) > 0 {
-		found, err := isResourceRegistered(c.discoveryClient, schema.GroupVersionKind{
-			Group:   "resource.k8s.io",
-			Version: "v1",
-			Kind:    "DeviceClass",
-		})
-		if err != nil {
+	// The DRA APIs are checked again on full syncs while they are not served, so that the
+	// DRA feature gate is enabled as soon as the cluster is upgraded. The
+	// deviceClassMappings config is preserved in the configmap so it takes effect then.
+	if syncCtx.QueueKey() == factory.DefaultQueueKey {
+		if err := c.refreshDRASupport(ctx, syncCtx.Queue()); err != nil {
 			klog.Errorf("unable to check if DRA APIs are available: %v", err)
-		} else if found {
-			c.draSupported = true
-		} else {
-			klog.Warningf("DRA APIs (resource.k8s.io/v1) not available on this cluster. DRA requires Kubernetes 1.34+ (OCP 4.21+)")
-			c.eventRecorder.Eventf("DRAUnsupported", "DRA APIs not available, deviceClassMappings will not take effect until the cluster is upgraded")
-			missingDependencies = append(missingDependencies, "DRA (Dynamic Resource Allocation) requires Kubernetes 1.34+ (OCP 4.21+)")
 		}
 	}
+	if !c.draSupported && len(kueue.Spec.Config.Resources.DeviceClassMappings) > 0 {
+		klog.Warningf("DRA APIs (resource.k8s.io/v1) not available on this cluster. DRA requires Kubernetes 1.34+ (OCP 4.21+)")
+		c.eventRecorder.Eventf("DRAUnsupported", "DRA APIs not available, deviceClassMappings will not take effect until the cluster is upgraded")
+		missingDependencies = append(missingDependencies, "DRA (Dynamic Resource Allocation) requires Kubernetes 1.34+ (OCP 4.21+)")
+	}
+	deviceClassMappings, deviceClassCondition, err := c.manageDeviceClassMappings(kueue)
+	if err != nil {
+		klog.Errorf("unable to check DeviceClasses: %v", err)
+		return err
+	}
+	kueueConfig.Resources.DeviceClassMappings = deviceClassMappings
 
+	dependencyCondition := applyoperatorv1.OperatorCondition().
+		WithType("DependenciesDegraded").
+		WithStatus(operatorv1.ConditionFalse).
+		WithReason("AsExpected").
+		WithMessage("")
 	if len(missingDependencies) > 0 {
-		dependencyCondition = applyoperatorv1.OperatorCondition().
-			WithType("Degraded").
+		dependencyCondition = dependencyCondition.
 			WithStatus(operatorv1.ConditionTrue).
 			WithReason("MissingDependencies").
 			WithMessage(fmt.Sprintf("Please install the following on your cluster: %s", strings.Join(missingDependencies, ", ")))
@@ -367,12 +464,44 @@ func (c *TargetConfigReconciler) sync(ctx context.Context, syncCtx factory.SyncC
 
 		cleanupResources := []func(context.Context) error{
 			c.cleanUpWebhooks,
+			c.cleanUpAdmissionPolicies,
+			c.cleanUpLimitRanges,
 			c.cleanUpCertificatesAndIssuers,
 			c.cleanUpClusterRoles,
 			c.cleanUpClusterRoleBindings,
+			c.cleanUpDashboards,
 			c.cleanUpResources,
 		}
 
+		switch kueue.Spec.UninstallPolicy {
+		case kueuev1.UninstallPolicyBlock:
+			held, err := c.holdUninstall(ctx, kueue)
+			if err != nil {
+				return err
+			}
+			if held {
+				syncCtx.Queue().AddAfter(syncCtx.QueueKey(), time.Minute)
+				return nil
+			}
+		case kueuev1.UninstallPolicyReleaseAll:
+			// The workloads are released once the Kueue webhooks are deleted, so that
+			// they are not suspended again on update.
+			releaseWorkloads := func(ctx context.Context) error {
+				return c.releaseWorkloads(ctx, kueue)
+			}
+			cleanupResources = slices.Insert(cleanupResources, 1, releaseWorkloads)
+		}
+		if kueue.Spec.RemovalPolicy == kueuev1.RemovalPolicyDelete {
+			cleanupResources = append(cleanupResources, c.deleteKueueCustomResources)
+		}
+		// The export is taken before anything is deleted.
+		if kueue.Spec.UninstallExport.ConfigMap.Name != "" {
+			exportKueueResources := func(ctx context.Context) error {
+				return c.exportKueueResources(ctx, kueue)
+			}
+			cleanupResources = slices.Insert(cleanupResources, 0, exportKueueResources)
+		}
+
 		for _, step := range cleanupResources {
 			if err := step(ctx); err != nil {
 				return err
@@ -390,428 +519,111 @@ func (c *TargetConfigReconciler) sync(ctx context.Context, syncCtx factory.SyncC
 		return nil
 	}
 
-	issuer, _, err := c.manageIssuerCR(ctx, kueue)
-	if err != nil {
-		klog.Errorf("unable to manage issuer err: %v", err)
-		return err
-	}
-	hash, err := computeSpecHash(issuer.Object["spec"])
-	if err != nil {
-		return fmt.Errorf("failed to hash Issuer spec: %w", err)
-	}
-	specAnnotations["issuer/"+issuer.GetName()] = hash
-
-	certificateData := []struct {
-		dnsNames        []interface{}
-		commonName      string
-		secretName      string
-		certificateName string
-	}{
-		{
-			dnsNames: []interface{}{
-				fmt.Sprintf("kueue-webhook-service.%s.svc", c.operatorNamespace),
-				fmt.Sprintf("kueue-webhook-service.%s.svc.cluster.local", c.operatorNamespace),
-			},
-			commonName:      "",
-			secretName:      "kueue-webhook-server-cert",
-			certificateName: "webhook-cert",
-		},
-		{
-			dnsNames: []interface{}{
-				fmt.Sprintf("kueue-controller-manager-metrics-service.%s.svc", c.operatorNamespace),
-				fmt.Sprintf("kueue-controller-manager-metrics-service.%s.svc.cluster.local", c.operatorNamespace),
-			},
-			commonName:      "kueue-metrics",
-			secretName:      "metrics-server-cert",
-			certificateName: "metrics-certs",
-		},
-		{
-			dnsNames: []interface{}{
-				fmt.Sprintf("kueue-visibility-server.%s.svc", c.operatorNamespace),
-				fmt.Sprintf("kueue-visibility-server.%s.svc.cluster.local", c.operatorNamespace),
-			},
-			commonName:      "kueue-visibility-server",
-			secretName:      "kueue-visibility-server-cert",
-			certificateName: "kueue-visibility-server-cert",
-		},
-	}
-
-	for _, certificate := range certificateData {
-		cert, _, err := c.manageCertificateCR(ctx, kueue, certificate.dnsNames, certificate.commonName, certificate.secretName, certificate.certificateName)
-		if err != nil {
-			klog.Errorf("unable to manage certificate err: %v", err)
-			return err
-		}
-		hash, err = computeSpecHash(cert.Object["spec"])
-		if err != nil {
-			return fmt.Errorf("failed to hash Certificate spec: %w", err)
-		}
-		specAnnotations["certificate/"+cert.GetName()] = hash
-	}
-
-	// Wait for the webhook certificate to be ready before creating webhooks
-	// This prevents webhook timeout errors when the certificate isn't provisioned yet
-	err = cert.WaitForCertificateReady(ctx, c.dynamicClient, c.operatorNamespace, "webhook-cert", 2*time.Minute)
-	if err != nil {
-		klog.Warningf("Webhook certificate not ready yet: %v - will retry on next reconciliation", err)
-		return err
-	}
-
-	err = cert.WaitForCertificateReady(ctx, c.dynamicClient, c.operatorNamespace, "metrics-certs", 2*time.Minute)
-	if err != nil {
-		klog.Warningf("Metrics certificate not ready yet: %v - will retry on next reconciliation", err)
-		return err
+	state := &operandState{
+		kueue:               kueue,
+		kueueConfig:         kueueConfig,
+		ownerReference:      ownerReference,
+		baseSpecAnnotations: specAnnotations,
 	}
-
-	err = cert.WaitForCertificateReady(ctx, c.dynamicClient, c.operatorNamespace, "kueue-visibility-server-cert", 2*time.Minute)
-	if err != nil {
-		klog.Warningf("Kueue Visibility certificate not ready yet: %v - will retry on next reconciliation", err)
-		return err
-	}
-
-	// Resolve TLS security profile from APIServer cluster-wide config
-	var tlsOpts *kueueconfigapi.TLSOptions
-	if c.isOpenShift {
-		clusterProfile, err := tlsprofile.FetchAPIServerTLSProfile(ctx, c.openshiftConfigClient)
-		if err != nil {
-			klog.Warningf("Failed to fetch TLS profile from APIServer CR: %v - will retry", err)
-			return err
-		}
-		tlsOpts, err = tlsprofile.TLSOptionsFromProfile(clusterProfile)
-		if err != nil {
-			klog.Errorf("Unsupported TLS profile: %v", err)
-			c.eventRecorder.Eventf("UnsupportedTLSProfile", "%v", err)
-
-			conditions := c.buildUnsupportedTLSProfileConditions(err)
-			if statusErr := c.updateKueueStatus(ctx, kueue, conditions, nil); statusErr != nil {
-				klog.Errorf("failed to update status: %v", statusErr)
-				return statusErr
-			}
-			return nil
-		}
-		if tlsOpts != nil {
-			klog.Infof("TLS Options - MinVersion: %s, CipherSuites: %v", tlsOpts.MinVersion, tlsOpts.CipherSuites)
-		}
-	}
-
-	cm, _, err := c.manageConfigMap(ctx, kueue, tlsOpts)
-	if err != nil {
-		return err
-	}
-	if cm != nil {
-		hash, err = computeSpecHash(cm.Data)
-		if err != nil {
-			return fmt.Errorf("failed to hash ConfigMap data: %w", err)
-		}
-		specAnnotations["configmap/"+cm.Name] = hash
-	}
-
-	sa, _, err := c.manageServiceAccount(ctx, ownerReference)
-	if err != nil {
-		klog.Error("unable to manage service account")
-		return err
-	}
-	// ServiceAccount has no spec field; hash only the name to avoid
-	// including mutable metadata (resourceVersion) that causes rollout loops.
-	hash, err = computeSpecHash(sa.Name)
-	if err != nil {
-		return fmt.Errorf("failed to hash ServiceAccount: %w", err)
-	}
-	specAnnotations["serviceaccounts/"+sa.Name] = hash
-
-	leaderRole, _, err := c.manageRole(ctx, "assets/kueue-operator/role-leader-election.yaml", ownerReference)
-	if err != nil {
-		klog.Error("unable to create role leader-election")
-		return err
-	}
-	hash, err = computeSpecHash(leaderRole.Rules)
-	if err != nil {
-		return fmt.Errorf("failed to hash Role rules: %w", err)
-	}
-	specAnnotations["role/"+leaderRole.Name] = hash
-
-	roleBindingLeader, _, err := c.manageRoleBindings(ctx, "assets/kueue-operator/rolebinding-leader-election.yaml", ownerReference, true)
-	if err != nil {
-		klog.Error("unable to bind role leader-election")
-		return err
-	}
-	hash, err = computeSpecHash([]interface{}{roleBindingLeader.Subjects, roleBindingLeader.RoleRef})
-	if err != nil {
-		return fmt.Errorf("failed to hash RoleBinding: %w", err)
-	}
-	specAnnotations["rolebinding/"+roleBindingLeader.Name] = hash
-
-	managerSecretsRole, _, err := c.manageRole(ctx, "assets/kueue-operator/role-manager-secrets.yaml", ownerReference)
-	if err != nil {
-		klog.Error("unable to create role manager-secrets")
-		return err
-	}
-	hash, err = computeSpecHash(managerSecretsRole.Rules)
-	if err != nil {
-		return fmt.Errorf("failed to hash Role rules: %w", err)
-	}
-	specAnnotations["role/"+managerSecretsRole.Name] = hash
-
-	roleBindingManagerSecrets, _, err := c.manageRoleBindings(ctx, "assets/kueue-operator/rolebinding-manager-secrets.yaml", ownerReference, true)
-	if err != nil {
-		klog.Error("unable to bind role manager-secrets")
-		return err
-	}
-	hash, err = computeSpecHash([]interface{}{roleBindingManagerSecrets.Subjects, roleBindingManagerSecrets.RoleRef})
-	if err != nil {
-		return fmt.Errorf("failed to hash RoleBinding: %w", err)
-	}
-	specAnnotations["rolebinding/"+roleBindingManagerSecrets.Name] = hash
-
-	if c.serviceMonitorSupport {
-		prometheusRole, _, err := c.manageRole(ctx, "assets/kueue-operator/role-prometheus.yaml", ownerReference)
-		if err != nil {
-			klog.Error("unable to create role prometheus")
-			return err
-		}
-		hash, err = computeSpecHash(prometheusRole.Rules)
-		if err != nil {
-			return fmt.Errorf("failed to hash Role rules: %w", err)
-		}
-		specAnnotations["role/"+prometheusRole.Name] = hash
-
-		prometheusRB, _, err := c.manageRoleBindings(ctx, "assets/kueue-operator/rolebinding-prometheus.yaml", ownerReference, false)
-		if err != nil {
-			klog.Error("unable to bind role prometheus")
-			return err
-		}
-		hash, err = computeSpecHash([]interface{}{prometheusRB.Subjects, prometheusRB.RoleRef})
-		if err != nil {
-			return fmt.Errorf("failed to hash RoleBinding: %w", err)
-		}
-		specAnnotations["rolebinding/"+prometheusRB.Name] = hash
-
-		controllerService, _, err := c.manageService(ctx, "assets/kueue-operator/controller-manager-metrics-service.yaml", ownerReference)
-		if err != nil {
-			klog.Error("unable to manage metrics service")
-			return err
-		}
-		hash, err = computeSpecHash(controllerService.Spec)
-		if err != nil {
-			return fmt.Errorf("failed to hash Service spec: %w", err)
-		}
-		specAnnotations["service/"+controllerService.Name] = hash
-
-		promCRB, _, err := c.manageClusterRoleBindingsWithoutNamespaceOverride(ctx, "assets/kueue-operator/clusterrolebinding-metrics-monitoring.yaml", ownerReference)
-		if err != nil {
-			klog.Error("unable to manage metrics monitoring cluster role binding")
-			return err
-		}
-		hash, err = computeSpecHash([]interface{}{promCRB.Subjects, promCRB.RoleRef})
-		if err != nil {
-			return fmt.Errorf("failed to hash ClusterRoleBinding: %w", err)
-		}
-		specAnnotations["clusterrolebinding/"+promCRB.Name] = hash
-	}
-
-	visbilityService, _, err := c.manageService(ctx, "assets/kueue-operator/visibility-server.yaml", ownerReference)
-	if err != nil {
-		klog.Error("unable to manage visbility service")
-		return err
-	}
-	hash, err = computeSpecHash(visbilityService.Spec)
-	if err != nil {
-		return fmt.Errorf("failed to hash Service spec: %w", err)
-	}
-	specAnnotations["service/"+visbilityService.Name] = hash
-
-	// From here, we will create our cluster wide resources.
-	err = c.manageAPIService(ctx, specAnnotations, ownerReference)
-	if err != nil {
-		klog.Error("unable to manage visibility apiservice")
-		return err
-	}
-
-	err = c.managePriorityLevelConfiguration(ctx, specAnnotations, ownerReference)
-	if err != nil {
-		klog.Error("unable to manage visibility prioritylevelconfiguration")
-		return err
-	}
-
-	err = c.manageFlowSchema(ctx, specAnnotations, ownerReference)
-	if err != nil {
-		klog.Error("unable to manage visibility flowschema")
-		return err
-	}
-
-	if err := c.manageCustomResources(ctx, specAnnotations); err != nil {
-		klog.Error("unable to manage custom resource")
-		return err
-	}
-
-	if err := c.manageNetworkPolicies(ctx, specAnnotations, ownerReference); err != nil {
-		klog.Error("unable to manage network policies")
-		return err
-	}
-
-	if err := c.manageClusterRoles(ctx, specAnnotations, ownerReference); err != nil {
-		klog.Error("unable to manage cluster roles")
-		return err
+	state.targets = syncTargets(syncCtx.QueueKey())
+	if state.targets != nil {
+		metrics.RecordReconcile(metrics.ScopeTargeted)
+	} else {
+		metrics.RecordReconcile(metrics.ScopeFull)
 	}
+	conditions, subControllersErr := c.runSubControllers(ctx, state, c.subControllers())
+	conditions = append(conditions, dependencyCondition)
 
-	clusterRole, _, err := c.manageOpenshiftClusterRolesForKueue(ctx, ownerReference)
+	deployment, err := c.currentDeployment(state)
 	if err != nil {
-		klog.Error("unable to manage openshift cluster roles")
 		return err
 	}
-	hash, err = computeSpecHash(clusterRole.Rules)
-	if err != nil {
-		return fmt.Errorf("failed to hash ClusterRole rules: %w", err)
+	if !slices.ContainsFunc(conditions, func(condition *applyoperatorv1.OperatorConditionApplyConfiguration) bool {
+		return *condition.Type == operandSubController+operatorv1.OperatorStatusTypeAvailable
+	}) {
+		conditions = append(conditions, c.buildOperandAvailableCondition(deployment))
 	}
-	specAnnotations["clusterrole/"+clusterRole.Name] = hash
-
-	clusterRoleBindingForKueue, _, err := c.manageOpenshiftClusterRolesBindingForKueue(ctx, ownerReference)
-	if err != nil {
-		klog.Error("unable to manage openshift cluster roles binding")
-		return err
+	if deployment == nil {
+		// Kueue is not deployed yet, the queues wait for it.
+		deployment = &appsv1.Deployment{}
 	}
-	hash, err = computeSpecHash([]interface{}{clusterRoleBindingForKueue.Subjects, clusterRoleBindingForKueue.RoleRef})
-	if err != nil {
-		return fmt.Errorf("failed to hash ClusterRoleBinding: %w", err)
+	// The top-level conditions aggregate the ones of the sub-controllers and the
+	// dependencies.
+	conditions = append(conditions, kueueconditions.Aggregate(conditions)...)
+	conditions = append(conditions, c.buildExternalFrameworksCondition(kueue))
+	if deviceClassCondition != nil {
+		conditions = append(conditions, deviceClassCondition)
 	}
-	specAnnotations["clusterrolebinding/"+clusterRoleBindingForKueue.Name] = hash
-
-	proxyRB, _, err := c.manageClusterRoleBindings(ctx, "assets/kueue-operator/clusterrolebinding-proxy.yaml", ownerReference)
-	if err != nil {
-		klog.Error("unable to manage kube proxy cluster roles")
-		return err
+	if labelPolicyCondition != nil {
+		conditions = append(conditions, labelPolicyCondition)
 	}
-	hash, err = computeSpecHash([]interface{}{proxyRB.Subjects, proxyRB.RoleRef})
-	if err != nil {
-		return fmt.Errorf("failed to hash ClusterRoleBinding: %w", err)
-	}
-	specAnnotations["clusterrolebinding/"+proxyRB.Name] = hash
 
-	managerRB, _, err := c.manageClusterRoleBindings(ctx, "assets/kueue-operator/clusterrolebinding-manager.yaml", ownerReference)
-	if err != nil {
-		klog.Error("unable to manage cluster role kueue-manager")
-		return err
+	queuesCondition, queuesErr := c.manageQueues(ctx, kueue, deployment)
+	if queuesErr != nil {
+		klog.Errorf("unable to manage queues: %v", queuesErr)
 	}
-	hash, err = computeSpecHash([]interface{}{managerRB.Subjects, managerRB.RoleRef})
-	if err != nil {
-		return fmt.Errorf("failed to hash ClusterRoleBinding: %w", err)
+	if queuesCondition != nil {
+		conditions = append(conditions, queuesCondition)
 	}
-	specAnnotations["clusterrolebinding/"+managerRB.Name] = hash
-
-	if c.serviceMonitorSupport {
-		metricsRB, _, err := c.manageClusterRoleBindings(ctx, "assets/kueue-operator/clusterrolebinding-metrics.yaml", ownerReference)
-		if err != nil {
-			klog.Error("unable to manage cluster role kueue-manager")
-			return err
-		}
-		hash, err = computeSpecHash([]interface{}{metricsRB.Subjects, metricsRB.RoleRef})
-		if err != nil {
-			return fmt.Errorf("failed to hash ClusterRoleBinding: %w", err)
-		}
-		specAnnotations["clusterrolebinding/"+metricsRB.Name] = hash
 
-		metricsAuthRB, _, err := c.manageClusterRoleBindings(ctx, "assets/kueue-operator/clusterrolebinding-metrics-auth.yaml", ownerReference)
-		if err != nil {
-			klog.Error("unable to manage metrics auth cluster role binding")
-			return err
-		}
-		hash, err = computeSpecHash([]interface{}{metricsAuthRB.Subjects, metricsAuthRB.RoleRef})
-		if err != nil {
-			return fmt.Errorf("failed to hash ClusterRoleBinding: %w", err)
-		}
-		specAnnotations["clusterrolebinding/"+metricsAuthRB.Name] = hash
-	}
-
-	roleBindingVisibility, _, err := c.manageSystemRoleBindings(ctx, "assets/kueue-operator/rolebinding-visibility-server-auth-reader.yaml", ownerReference, true)
-	if err != nil {
-		klog.Error("unable to bind role binding for visibility")
-		return err
-	}
-	hash, err = computeSpecHash([]interface{}{roleBindingVisibility.Subjects, roleBindingVisibility.RoleRef})
-	if err != nil {
-		return fmt.Errorf("failed to hash RoleBinding: %w", err)
-	}
-	specAnnotations["rolebinding/"+roleBindingVisibility.Name] = hash
-
-	kueueWH, _, err := c.manageMutatingWebhook(ctx, kueue, ownerReference)
-	if err != nil {
-		klog.Error("unable to manage mutating webhook")
-		return err
+	queueAssignmentCondition, queueAssignmentErr := c.manageQueueAssignment(ctx, kueue, kueueConfig)
+	if queueAssignmentErr != nil {
+		klog.Errorf("unable to manage queue assignment: %v", queueAssignmentErr)
+		queueAssignmentCondition = applyoperatorv1.OperatorCondition().
+			WithType(queueAssignmentReadyConditionType).
+			WithStatus(operatorv1.ConditionFalse).
+			WithReason("ApplyFailed").
+			WithMessage(queueAssignmentErr.Error())
 	}
-	hash, err = computeSpecHash(kueueWH.Webhooks)
-	if err != nil {
-		return fmt.Errorf("failed to hash MutatingWebhookConfiguration webhooks: %w", err)
+	if queueAssignmentCondition != nil {
+		conditions = append(conditions, queueAssignmentCondition)
 	}
-	specAnnotations["mutatingwebhook/"+kueueWH.Name] = hash
 
-	kueueVWH, _, err := c.manageValidatingWebhook(ctx, kueue, ownerReference)
-	if err != nil {
-		klog.Error("unable to manage validating webhook")
-		return err
+	guardrailsCondition, guardrailsErr := c.manageTenantGuardrails(ctx, kueue, kueueConfig)
+	if guardrailsErr != nil {
+		klog.Errorf("unable to manage tenant guardrails: %v", guardrailsErr)
+		guardrailsCondition = applyoperatorv1.OperatorCondition().
+			WithType(tenantGuardrailsReadyConditionType).
+			WithStatus(operatorv1.ConditionFalse).
+			WithReason("ApplyFailed").
+			WithMessage(guardrailsErr.Error())
 	}
-	hash, err = computeSpecHash(kueueVWH.Webhooks)
-	if err != nil {
-		return fmt.Errorf("failed to hash ValidatingWebhookConfiguration webhooks: %w", err)
+	if guardrailsCondition != nil {
+		conditions = append(conditions, guardrailsCondition)
 	}
-	specAnnotations["validatingwebhook/"+kueueVWH.Name] = hash
 
-	webhookService, _, err := c.manageService(ctx, "assets/kueue-operator/webhook-service.yaml", ownerReference)
-	if err != nil {
-		klog.Error("unable to manage webhook service")
-		return err
-	}
-	hash, err = computeSpecHash(webhookService.Spec)
-	if err != nil {
-		return fmt.Errorf("failed to hash Service spec: %w", err)
+	protectionCondition, protectionErr := c.manageNamespaceProtection(ctx, kueue)
+	if protectionErr != nil {
+		klog.Errorf("unable to manage namespace protection: %v", protectionErr)
+		protectionCondition = applyoperatorv1.OperatorCondition().
+			WithType(namespaceProtectionReadyConditionType).
+			WithStatus(operatorv1.ConditionFalse).
+			WithReason("ApplyFailed").
+			WithMessage(protectionErr.Error())
 	}
-	specAnnotations["service/"+webhookService.Name] = hash
-
-	if c.serviceMonitorSupport {
-		serviceMonitor, _, err := c.manageServiceMonitor(ctx, kueue)
-		if err != nil {
-			return err
-		}
-		hash, err = computeSpecHash(serviceMonitor.Object["spec"])
-		if err != nil {
-			return fmt.Errorf("failed to hash ServiceMonitor spec: %w", err)
-		}
-		specAnnotations["servicemonitor/"+serviceMonitor.GetName()] = hash
+	if protectionCondition != nil {
+		conditions = append(conditions, protectionCondition)
 	}
-
-	deployment, _, err := c.manageDeployment(ctx, kueue, specAnnotations, ownerReference)
-	if err != nil {
-		klog.Error("unable to manage deployment")
+	effective := configmap.EffectiveConfiguration(kueueConfig, c.draSupported)
+	effective.ConfigHash = c.subControllerResults[operandSubController].specAnnotations["configmap/"+KueueConfigMap]
+	if err := c.updateKueueStatus(ctx, kueue, conditions, &deployment.Status.ReadyReplicas, effective); err != nil {
 		return err
 	}
-
-	conditions := c.buildOperatorConditions(deployment, dependencyCondition)
-	return c.updateKueueStatus(ctx, kueue, conditions, &deployment.Status.ReadyReplicas)
+	return utilerror.NewAggregate([]error{subControllersErr, queuesErr, queueAssignmentErr, guardrailsErr, protectionErr})
 }
 
-func (c *TargetConfigReconciler) buildOperatorConditions(deployment *appsv1.Deployment, dependencyCondition *applyoperatorv1.OperatorConditionApplyConfiguration) []*applyoperatorv1.OperatorConditionApplyConfiguration {
+// buildOperatorConditions reports the state of the Kueue deployment in the Operand
+// conditions.
+func (c *TargetConfigReconciler) buildOperatorConditions(deployment *appsv1.Deployment) []*applyoperatorv1.OperatorConditionApplyConfiguration {
 	desired := int32(1)
 	if deployment.Spec.Replicas != nil {
 		desired = *deployment.Spec.Replicas
 	}
 	ready := deployment.Status.ReadyReplicas
 
-	// Available condition
-	availableCond := applyoperatorv1.OperatorCondition().
-		WithType("Available").
-		WithStatus(operatorv1.ConditionFalse).
-		WithReason("NotEnoughReplicas").
-		WithMessage(fmt.Sprintf("%d/%d replicas are ready", ready, desired))
-
-	if ready == desired && ready > 0 {
-		availableCond = availableCond.
-			WithStatus(operatorv1.ConditionTrue).
-			WithReason("AllReplicasReady").
-			WithMessage(fmt.Sprintf("All %d replicas are ready", ready))
-	}
-
 	// Progressing condition
 	progressingCond := applyoperatorv1.OperatorCondition().
-		WithType("Progressing").
+		WithType(operandSubController + operatorv1.OperatorStatusTypeProgressing).
 		WithStatus(operatorv1.ConditionTrue).
 		WithReason("Reconciling").
 		WithMessage("Deployment is reconciling")
@@ -824,7 +636,7 @@ func (c *TargetConfigReconciler) buildOperatorConditions(deployment *appsv1.Depl
 
 	// Degraded condition - check for partial failure (some replicas unavailable) or complete failure (no replicas ready).
 	degradedCond := applyoperatorv1.OperatorCondition().
-		WithType("Degraded").
+		WithType(operandSubController + operatorv1.OperatorStatusTypeDegraded).
 		WithStatus(operatorv1.ConditionFalse).
 		WithReason("AsExpected").
 		WithMessage("")
@@ -837,69 +649,59 @@ func (c *TargetConfigReconciler) buildOperatorConditions(deployment *appsv1.Depl
 			WithReason("NoReplicasReady").
 			WithMessage(fmt.Sprintf("No replicas ready (desired: %d)", desired))
 	}
-	if dependencyCondition != nil {
-		degradedCond = dependencyCondition
-	}
-
-	// cert-manager is installed and available.
-	certManagerCond := applyoperatorv1.OperatorCondition().
-		WithType("CertManagerAvailable").
-		WithStatus(operatorv1.ConditionTrue).
-		WithReason("CertManagerInstalled").
-		WithMessage("cert-manager is installed")
 
 	return []*applyoperatorv1.OperatorConditionApplyConfiguration{
-		availableCond,
+		c.buildOperandAvailableCondition(deployment),
 		progressingCond,
 		degradedCond,
-		certManagerCond,
 	}
 }
 
-// buildCertManagerMissingConditions creates operator conditions when cert-manager is not installed.
-func (c *TargetConfigReconciler) buildCertManagerMissingConditions() []*applyoperatorv1.OperatorConditionApplyConfiguration {
-	degradedCond := applyoperatorv1.OperatorCondition().
-		WithType("Degraded").
-		WithStatus(operatorv1.ConditionTrue).
-		WithReason("MissingDependency").
-		WithMessage("please make sure that cert-manager is installed on your cluster")
-
+// buildOperandAvailableCondition reports whether all the replicas of the Kueue deployment
+// are ready. The deployment is nil when Kueue is not deployed.
+func (c *TargetConfigReconciler) buildOperandAvailableCondition(deployment *appsv1.Deployment) *applyoperatorv1.OperatorConditionApplyConfiguration {
 	availableCond := applyoperatorv1.OperatorCondition().
-		WithType("Available").
-		WithStatus(operatorv1.ConditionFalse).
-		WithReason("MissingDependency").
-		WithMessage("cert-manager is required but not installed")
-
-	progressingCond := applyoperatorv1.OperatorCondition().
-		WithType("Progressing").
-		WithStatus(operatorv1.ConditionFalse).
-		WithReason("MissingDependency").
-		WithMessage("waiting for cert-manager to be installed")
+		WithType(operandSubController + operatorv1.OperatorStatusTypeAvailable).
+		WithStatus(operatorv1.ConditionFalse)
+	if deployment == nil {
+		return availableCond.
+			WithReason("DeploymentNotFound").
+			WithMessage("The Kueue deployment is not created yet")
+	}
 
-	return []*applyoperatorv1.OperatorConditionApplyConfiguration{
-		availableCond,
-		progressingCond,
-		degradedCond,
+	desired := int32(1)
+	if deployment.Spec.Replicas != nil {
+		desired = *deployment.Spec.Replicas
+	}
+	ready := deployment.Status.ReadyReplicas
+	if ready == desired && ready > 0 {
+		return availableCond.
+			WithStatus(operatorv1.ConditionTrue).
+			WithReason("AllReplicasReady").
+			WithMessage(fmt.Sprintf("All %d replicas are ready", ready))
 	}
+	return availableCond.
+		WithReason("NotEnoughReplicas").
+		WithMessage(fmt.Sprintf("%d/%d replicas are ready", ready, desired))
 }
 
 // buildUnsupportedTLSProfileConditions creates operator conditions when the cluster TLS profile
 // is not supported by Kueue (e.g., Old profile with TLS 1.0).
 func (c *TargetConfigReconciler) buildUnsupportedTLSProfileConditions(tlsErr error) []*applyoperatorv1.OperatorConditionApplyConfiguration {
 	degradedCond := applyoperatorv1.OperatorCondition().
-		WithType("Degraded").
+		WithType(operandSubController + operatorv1.OperatorStatusTypeDegraded).
 		WithStatus(operatorv1.ConditionTrue).
 		WithReason("UnsupportedTLSProfile").
 		WithMessage(fmt.Sprintf("The cluster TLS security profile is not supported: %v. Kueue requires a minimum TLS version of 1.2. Please use Intermediate, Modern, or a Custom profile with at least VersionTLS12.", tlsErr))
 
 	availableCond := applyoperatorv1.OperatorCondition().
-		WithType("Available").
+		WithType(operandSubController + operatorv1.OperatorStatusTypeAvailable).
 		WithStatus(operatorv1.ConditionFalse).
 		WithReason("UnsupportedTLSProfile").
 		WithMessage("Kueue cannot be configured with the current cluster TLS profile")
 
 	progressingCond := applyoperatorv1.OperatorCondition().
-		WithType("Progressing").
+		WithType(operandSubController + operatorv1.OperatorStatusTypeProgressing).
 		WithStatus(operatorv1.ConditionFalse).
 		WithReason("UnsupportedTLSProfile").
 		WithMessage("waiting for a supported TLS profile to be configured")
@@ -911,9 +713,27 @@ func (c *TargetConfigReconciler) buildUnsupportedTLSProfileConditions(tlsErr err
 	}
 }
 
-// updateKueueStatus updates the Kueue CR status with the provided conditions.
-func (c *TargetConfigReconciler) updateKueueStatus(ctx context.Context, kueue *kueuev1.Kueue, conditions []*applyoperatorv1.OperatorConditionApplyConfiguration, readyReplicas *int32) error {
-	status := applyconfigurationkueueoperatorv1.KueueStatus().WithConditions(conditions...)
+// updateKueueStatus updates the Kueue CR status with the provided conditions and the
+// effective configuration of Kueue.
+func (c *TargetConfigReconciler) updateKueueStatus(ctx context.Context, kueue *kueuev1.Kueue, conditions []*applyoperatorv1.OperatorConditionApplyConfiguration, readyReplicas *int32, effective kueuev1.EffectiveConfiguration) error {
+	status := applyconfigurationkueueoperatorv1.KueueStatus().
+		WithConditions(conditions...).
+		WithIntegrations(integrationStatusApplyConfigurations(c.integrationStatuses)...).
+		WithDiscoveredDeviceClassMappings(deviceClassMappingApplyConfigurations(c.discoveredDeviceClassMappings)...).
+		WithEffectiveConfiguration(effectiveConfigurationApplyConfiguration(effective)).
+		WithKueueImage(c.kueueImage)
+	if c.kueueVersion != "" {
+		status.WithKueueVersion(c.kueueVersion)
+	}
+
+	// The related objects are listed from the informer caches, a failure does not block
+	// the rest of the status.
+	relatedObjects, err := c.relatedObjects()
+	if err != nil {
+		klog.Errorf("unable to list the related objects: %v", err)
+	} else {
+		status.WithRelatedObjects(relatedObjectApplyConfigurations(relatedObjects)...)
+	}
 
 	// Set ReadyReplicas if provided
 	if readyReplicas != nil {
@@ -931,7 +751,7 @@ func (c *TargetConfigReconciler) updateKueueStatus(ctx context.Context, kueue *k
 	v1helpers.SetApplyConditionsLastTransitionTime(clock.RealClock{}, &status.Conditions, existingConditions)
 
 	config := applyconfigurationkueueoperatorv1.Kueue("cluster").WithStatus(status)
-	_, err := c.operatorClient.Kueues().ApplyStatus(ctx, config, metav1.ApplyOptions{FieldManager: "kueue-operator"})
+	_, err = c.operatorClient.Kueues().ApplyStatus(ctx, config, metav1.ApplyOptions{FieldManager: "kueue-operator"})
 	return err
 }
 
@@ -1046,6 +866,12 @@ func (c *TargetConfigReconciler) cleanUpResources(ctx context.Context) error {
 		klog.Infof("Successfully deleted Secret: %s/%s", c.operatorNamespace, "metrics-server-cert")
 	}
 
+	err = c.kubeClient.CoreV1().ConfigMaps(c.operatorNamespace).Delete(ctx, removedIntegrationsConfigMap, metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		klog.Errorf("Failed to delete ConfigMap %s/%s: %v", c.operatorNamespace, removedIntegrationsConfigMap, err)
+		errorList = append(errorList, err)
+	}
+
 	if len(errorList) > 0 {
 		return utilerror.NewAggregate(errorList)
 	}
@@ -1136,6 +962,10 @@ func (c *TargetConfigReconciler) cleanUpCertificatesAndIssuers(ctx context.Conte
 		}
 
 		crList, err := c.dynamicClient.Resource(gvr).Namespace(c.operatorNamespace).List(ctx, metav1.ListOptions{})
+		if errors.IsNotFound(err) {
+			// cert-manager is not installed, there is nothing to clean up.
+			continue
+		}
 		if err != nil {
 			klog.Errorf("Failed to list instances of %s: %v", resource, err)
 			errorList = append(errorList, err)
@@ -1203,6 +1033,31 @@ func (c *TargetConfigReconciler) cleanUpClusterRoles(ctx context.Context) error
 	return nil
 }
 
+// cleanUpDashboards deletes the dashboards of the OpenShift console.
+func (c *TargetConfigReconciler) cleanUpDashboards(ctx context.Context) error {
+	if !c.isOpenShift {
+		return nil
+	}
+	files, err := bindata.AssetDir(dashboardDir)
+	if err != nil {
+		return fmt.Errorf("failed to read dashboards from directory %q: %w", dashboardDir, err)
+	}
+
+	var errorList []error
+	for _, file := range files {
+		dashboard := resourceread.ReadConfigMapV1OrDie(bindata.MustAsset(filepath.Join(dashboardDir, file)))
+		klog.Infof("Deleting dashboard: %s/%s", dashboard.Namespace, dashboard.Name)
+		err := retry.OnError(retry.DefaultBackoff, errors.IsTooManyRequests, func() error {
+			return c.kubeClient.CoreV1().ConfigMaps(dashboard.Namespace).Delete(ctx, dashboard.Name, metav1.DeleteOptions{})
+		})
+		if err != nil && !errors.IsNotFound(err) {
+			klog.Errorf("Failed to delete dashboard %s/%s: %v", dashboard.Namespace, dashboard.Name, err)
+			errorList = append(errorList, err)
+		}
+	}
+	return utilerror.NewAggregate(errorList)
+}
+
 func (c *TargetConfigReconciler) cleanUpClusterRoleBindings(ctx context.Context) error {
 	var errorList []error
 	clusterRoleBindingList, err := c.kubeClient.RbacV1().ClusterRoleBindings().List(ctx, metav1.ListOptions{})
@@ -1237,21 +1092,21 @@ func (c *TargetConfigReconciler) cleanUpClusterRoleBindings(ctx context.Context)
 	return nil
 }
 
-func (c *TargetConfigReconciler) manageConfigMap(ctx context.Context, kueue *kueuev1.Kueue, tlsOpts *kueueconfigapi.TLSOptions) (*v1.ConfigMap, bool, error) {
+func (c *TargetConfigReconciler) manageConfigMap(ctx context.Context, kueueCfg kueuev1.KueueConfiguration, tlsOpts *kueueconfigapi.TLSOptions) (*v1.ConfigMap, bool, error) {
 	required, err := c.kubeClient.CoreV1().ConfigMaps(c.operatorNamespace).Get(ctx, KueueConfigMap, metav1.GetOptions{})
 
 	var gvrToKind map[string]string
-	if kueue.Spec.Config.MultiKueue != nil {
-		gvrToKind = c.resolveGVRsToKinds(kueue.Spec.Config.MultiKueue.ExternalFrameworks)
+	if kueueCfg.MultiKueue != nil {
+		gvrToKind = c.resolveGVRsToKinds(kueueCfg.MultiKueue.ExternalFrameworks)
 	}
 
 	if errors.IsNotFound(err) {
-		return c.buildAndApplyConfigMap(ctx, nil, kueue.Spec.Config, gvrToKind, tlsOpts)
+		return c.buildAndApplyConfigMap(ctx, nil, kueueCfg, gvrToKind, tlsOpts)
 	} else if err != nil {
 		klog.Errorf("Cannot load ConfigMap %s/kueue-manager-config for the kueue operator", c.operatorNamespace)
 		return nil, false, err
 	}
-	return c.buildAndApplyConfigMap(ctx, required, kueue.Spec.Config, gvrToKind, tlsOpts)
+	return c.buildAndApplyConfigMap(ctx, required, kueueCfg, gvrToKind, tlsOpts)
 }
 
 func (c *TargetConfigReconciler) resolveGVRsToKinds(frameworks []kueuev1.ExternalFramework) map[string]string {
@@ -1286,6 +1141,11 @@ func (c *TargetConfigReconciler) buildAndApplyConfigMap(ctx context.Context, old
 		return oldCfgMap, false, nil
 	}
 	klog.InfoS("Configmap difference detected", "Namespace", c.operatorNamespace, "ConfigMap", KueueConfigMap)
+	if oldCfgMap != nil {
+		if err := c.recordRemovedIntegrations(ctx, oldCfgMap, cfgMap); err != nil {
+			return nil, false, err
+		}
+	}
 	return resourceapply.ApplyConfigMapImproved(ctx, c.kubeClient.CoreV1(), c.eventRecorder, cfgMap, c.resourceCache)
 }
 
@@ -1309,6 +1169,7 @@ func (c *TargetConfigReconciler) manageFlowSchema(ctx context.Context, specAnnot
 		ownerReference,
 	}
 
+	setManagedByLabel(want)
 	flowSchema, _, err := utilresourceapply.ApplyFlowSchema(ctx, c.kubeClient.FlowcontrolV1(), c.eventRecorder, want)
 	if err != nil {
 		return err
@@ -1342,32 +1203,34 @@ func (c *TargetConfigReconciler) managePriorityLevelConfiguration(ctx context.Co
 	return nil
 }
 
-func (c *TargetConfigReconciler) manageMutatingWebhook(ctx context.Context, kueue *kueuev1.Kueue, ownerReference metav1.OwnerReference) (*admissionregistrationv1.MutatingWebhookConfiguration, bool, error) {
+func (c *TargetConfigReconciler) manageMutatingWebhook(ctx context.Context, kueueCfg kueuev1.KueueConfiguration, ownerReference metav1.OwnerReference) (*admissionregistrationv1.MutatingWebhookConfiguration, bool, error) {
 	required := resourceread.ReadMutatingWebhookConfigurationV1OrDie(bindata.MustAsset("assets/kueue-operator/mutatingwebhook.yaml"))
 	required.OwnerReferences = []metav1.OwnerReference{
 		ownerReference,
 	}
 
-	newWebhook := webhook.ModifyPodBasedMutatingWebhook(kueue.Spec.Config, required)
+	newWebhook := webhook.ModifyPodBasedMutatingWebhook(kueueCfg, required)
 	for i := range newWebhook.Webhooks {
 		newWebhook.Webhooks[i].ClientConfig.Service.Namespace = c.operatorNamespace
 	}
 	newWebhook.Annotations = cert.InjectCertAnnotation(newWebhook.Annotations, c.operatorNamespace)
+	setManagedByLabel(newWebhook)
 	return resourceapply.ApplyMutatingWebhookConfigurationImproved(ctx, c.kubeClient.AdmissionregistrationV1(), c.eventRecorder, newWebhook, c.resourceCache)
 }
 
-func (c *TargetConfigReconciler) manageValidatingWebhook(ctx context.Context, kueue *kueuev1.Kueue, ownerReference metav1.OwnerReference) (*admissionregistrationv1.ValidatingWebhookConfiguration, bool, error) {
+func (c *TargetConfigReconciler) manageValidatingWebhook(ctx context.Context, kueueCfg kueuev1.KueueConfiguration, ownerReference metav1.OwnerReference) (*admissionregistrationv1.ValidatingWebhookConfiguration, bool, error) {
 	required := resourceread.ReadValidatingWebhookConfigurationV1OrDie(bindata.MustAsset("assets/kueue-operator/validatingwebhook.yaml"))
 	required.OwnerReferences = []metav1.OwnerReference{
 		ownerReference,
 	}
 	controller.EnsureOwnerRef(required, ownerReference)
 
-	newWebhook := webhook.ModifyPodBasedValidatingWebhook(kueue.Spec.Config, required)
+	newWebhook := webhook.ModifyPodBasedValidatingWebhook(kueueCfg, required)
 	for i := range newWebhook.Webhooks {
 		newWebhook.Webhooks[i].ClientConfig.Service.Namespace = c.operatorNamespace
 	}
 	newWebhook.Annotations = cert.InjectCertAnnotation(newWebhook.Annotations, c.operatorNamespace)
+	setManagedByLabel(newWebhook)
 	return resourceapply.ApplyValidatingWebhookConfigurationImproved(ctx, c.kubeClient.AdmissionregistrationV1(), c.eventRecorder, newWebhook, c.resourceCache)
 }
 
@@ -1436,13 +1299,13 @@ func (c *TargetConfigReconciler) manageService(ctx context.Context, assetPath st
 	return resourceapply.ApplyServiceImproved(ctx, c.kubeClient.CoreV1(), c.eventRecorder, required, c.resourceCache)
 }
 
-func (c *TargetConfigReconciler) manageAPIService(ctx context.Context, specAnnotations map[string]string, ownerReference metav1.OwnerReference) error {
-	// Manage both v1beta1 and v1beta2 APIService versions
-	apiServiceFiles := []string{
-		"assets/kueue-operator/apiservice-v1beta1.visibility.kueue.x-k8s.io.yaml",
-		"assets/kueue-operator/apiservice-v1beta2.visibility.kueue.x-k8s.io.yaml",
-	}
+// apiServiceFiles are the APIServices of both the v1beta1 and v1beta2 visibility APIs.
+var apiServiceFiles = []string{
+	"assets/kueue-operator/apiservice-v1beta1.visibility.kueue.x-k8s.io.yaml",
+	"assets/kueue-operator/apiservice-v1beta2.visibility.kueue.x-k8s.io.yaml",
+}
 
+func (c *TargetConfigReconciler) manageAPIService(ctx context.Context, specAnnotations map[string]string, ownerReference metav1.OwnerReference) error {
 	for _, assetPath := range apiServiceFiles {
 		required := resourceread.ReadAPIServiceOrDie(bindata.MustAsset(assetPath))
 		required.Spec.InsecureSkipTLSVerify = false
@@ -1458,6 +1321,7 @@ func (c *TargetConfigReconciler) manageAPIService(ctx context.Context, specAnnot
 		required.OwnerReferences = []metav1.OwnerReference{
 			ownerReference,
 		}
+		setManagedByLabel(required)
 		apiService, _, err := resourceapply.ApplyAPIService(ctx, c.apiRegistrationClient, c.eventRecorder, required)
 		if err != nil {
 			return err
@@ -1471,7 +1335,10 @@ func (c *TargetConfigReconciler) manageAPIService(ctx context.Context, specAnnot
 	return nil
 }
 
-func (c *TargetConfigReconciler) manageClusterRoles(ctx context.Context, specAnnotations map[string]string, ownerReference metav1.OwnerReference) error {
+// manageClusterRoles applies the Kueue ClusterRoles shipped in the bindata.
+// The editor and viewer ClusterRoles of an integration are only applied when the
+// integration is enabled, and are deleted once it is disabled.
+func (c *TargetConfigReconciler) manageClusterRoles(ctx context.Context, kueueCfg kueuev1.KueueConfiguration, specAnnotations map[string]string, ownerReference metav1.OwnerReference) error {
 	clusterRoleDir := "assets/kueue-operator/clusterroles"
 
 	files, err := bindata.AssetDir(clusterRoleDir)
@@ -1486,9 +1353,16 @@ func (c *TargetConfigReconciler) manageClusterRoles(ctx context.Context, specAnn
 		if required.AggregationRule != nil {
 			continue
 		}
+		if !rbac.IsClusterRoleEnabled(required.Name, kueueCfg.Integrations.Frameworks) {
+			if err := c.deleteClusterRoleIfExists(ctx, required.Name); err != nil {
+				return err
+			}
+			continue
+		}
 		required.OwnerReferences = []metav1.OwnerReference{
 			ownerReference,
 		}
+		rbac.SetDefaultRoleAggregation(required, kueueCfg.RBAC.DefaultRoleAggregation)
 
 		role, _, err := c.applyClusterRoleWithCache(ctx, required)
 		if err != nil {
@@ -1503,26 +1377,94 @@ func (c *TargetConfigReconciler) manageClusterRoles(ctx context.Context, specAnn
 	return nil
 }
 
-func (c *TargetConfigReconciler) manageNetworkPolicies(ctx context.Context, specAnnotations map[string]string, ownerReference metav1.OwnerReference) error {
+// lookUpLegacyRBAC looks up directly an RBAC object missing from the informer cache,
+// as it may have been applied without the managed-by label by an earlier version of the
+// operator. Each object is looked up once, the informer cache is trusted afterwards.
+func (c *TargetConfigReconciler) lookUpLegacyRBAC(resource, name string, get func() error) error {
+	key := resource + "/" + name
+	c.legacyRBACLookupsLock.Lock()
+	lookedUp := c.legacyRBACLookups.Has(key)
+	c.legacyRBACLookupsLock.Unlock()
+	if lookedUp {
+		return errors.NewNotFound(rbacv1.Resource(resource), name)
+	}
+
+	err := get()
+	if err == nil || errors.IsNotFound(err) {
+		c.legacyRBACLookupsLock.Lock()
+		c.legacyRBACLookups.Insert(key)
+		c.legacyRBACLookupsLock.Unlock()
+	}
+	return err
+}
+
+// deleteClusterRoleIfExists deletes the named ClusterRole when it exists.
+func (c *TargetConfigReconciler) deleteClusterRoleIfExists(ctx context.Context, name string) error {
+	_, err := c.managedInformer.Rbac().V1().ClusterRoles().Lister().Get(name)
+	if errors.IsNotFound(err) {
+		err = c.lookUpLegacyRBAC("clusterroles", name, func() error {
+			_, err := c.kubeClient.RbacV1().ClusterRoles().Get(ctx, name, metav1.GetOptions{})
+			return err
+		})
+	}
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	klog.Infof("Deleting ClusterRole: %s", name)
+	err = retry.OnError(retry.DefaultBackoff, errors.IsTooManyRequests, func() error {
+		return c.kubeClient.RbacV1().ClusterRoles().Delete(ctx, name, metav1.DeleteOptions{})
+	})
+	if err != nil && !errors.IsNotFound(err) {
+		klog.Errorf("Failed to delete ClusterRole %s: %v", name, err)
+		return err
+	}
+	return nil
+}
+
+// deleteClusterRoleBindingIfExists deletes the named ClusterRoleBinding when it exists.
+func (c *TargetConfigReconciler) deleteClusterRoleBindingIfExists(ctx context.Context, name string) error {
+	_, err := c.managedInformer.Rbac().V1().ClusterRoleBindings().Lister().Get(name)
+	if errors.IsNotFound(err) {
+		err = c.lookUpLegacyRBAC("clusterrolebindings", name, func() error {
+			_, err := c.kubeClient.RbacV1().ClusterRoleBindings().Get(ctx, name, metav1.GetOptions{})
+			return err
+		})
+	}
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	klog.Infof("Deleting ClusterRoleBinding: %s", name)
+	err = retry.OnError(retry.DefaultBackoff, errors.IsTooManyRequests, func() error {
+		return c.kubeClient.RbacV1().ClusterRoleBindings().Delete(ctx, name, metav1.DeleteOptions{})
+	})
+	if err != nil && !errors.IsNotFound(err) {
+		klog.Errorf("Failed to delete ClusterRoleBinding %s: %v", name, err)
+		return err
+	}
+	return nil
+}
+
+// networkPolicySteps returns the steps applying the network policies of the operator and
+// Kueue. The deny-all policy is returned apart, to be applied once the policies allowing
+// the traffic are in place: applied first, it could cut the access of the operator to the
+// API server before the policy allowing it is created.
+func (c *TargetConfigReconciler) networkPolicySteps(ownerReference metav1.OwnerReference, metrics kueuev1.Metrics) ([]applyStep, []applyStep, error) {
 	networkPolicyDir := "assets/kueue-operator/networkpolicy"
 
 	files, err := bindata.AssetDir(networkPolicyDir)
 	if err != nil {
-		return fmt.Errorf("failed to read networkpolicy from directory %q: %w", networkPolicyDir, err)
-	}
-
-	// TODO: does the order of the creation of these policies matter?
-	// TODO: Since OLM does not support networkpolicy resource yet the
-	// operator Pod is creating policies for self isolation (in addition
-	// to operand isolation). let's say our operator creates the following
-	// network policy manifests for self and the operand in the following
-	// order: a) deny-all, b) allow-egress-api, c) allow egress cluster-dns,
-	// and d) allow-ingress-metrics; while creating these manifests in order,
-	// if there is a delay between a and b, long enough that deny-all takes
-	// effect and creation of b fails. If this can happen then the operator
-	// has lost access to the apiserver in a self inflicted manner. Should
-	// the operator create the deny-all policy last to avoid this issue?
-	var hash string
+		return nil, nil, fmt.Errorf("failed to read networkpolicy from directory %q: %w", networkPolicyDir, err)
+	}
+
+	var allowSteps, denyAllSteps []applyStep
 	for _, file := range files {
 		assetPath := filepath.Join(networkPolicyDir, file)
 		// TODO: move these resource helper functions to library-go
@@ -1543,21 +1485,37 @@ func (c *TargetConfigReconciler) manageNetworkPolicies(ctx context.Context, spec
 		}
 
 		// Special handling for webhook ingress/egress policy based on platform
-		if want.Name == "kueue-allow-ingress-egress-webhook" {
+		if want.Name == "kueue-allow-ingress-egress-webhook" || want.Name == "kueue-allow-ingress-operator-webhook" {
 			want = c.adjustWebhookNetworkPolicyForPlatform(want)
 		}
 
-		policy, _, err := c.applyNetworkPolicyWithCache(ctx, want)
-		if err != nil {
-			return err
+		if want.Name == "kueue-allow-ingress-egress-metrics" {
+			want = adjustMetricsNetworkPolicy(want, metrics.AdditionalNamespaces)
 		}
-		hash, err = computeSpecHash(policy.Spec)
-		if err != nil {
-			return fmt.Errorf("failed to hash NetworkPolicy spec: %w", err)
+
+		step := func(ctx context.Context, specAnnotations map[string]string) error {
+			policy, _, err := c.applyNetworkPolicyWithCache(ctx, want)
+			if err != nil {
+				return err
+			}
+			hash, err := computeSpecHash(policy.Spec)
+			if err != nil {
+				return fmt.Errorf("failed to hash NetworkPolicy spec: %w", err)
+			}
+			// The policy of the webhook server of the operator does not select Kueue pods.
+			if policy.Name == "kueue-allow-ingress-operator-webhook" {
+				return nil
+			}
+			specAnnotations["networkpolicy/"+policy.Name] = hash
+			return nil
+		}
+		if want.Name == denyAllNetworkPolicyName {
+			denyAllSteps = append(denyAllSteps, step)
+		} else {
+			allowSteps = append(allowSteps, step)
 		}
-		specAnnotations["networkpolicy/"+policy.Name] = hash
 	}
-	return nil
+	return allowSteps, denyAllSteps, nil
 }
 
 // adjustDNSNetworkPolicyForPlatform modifies the DNS egress NetworkPolicy based on the detected platform.
@@ -1647,6 +1605,24 @@ func (c *TargetConfigReconciler) adjustWebhookNetworkPolicyForPlatform(policy *n
 	return policy
 }
 
+// adjustMetricsNetworkPolicy admits the scrapes of the metrics endpoints from the
+// additional namespaces of the metrics configuration.
+func adjustMetricsNetworkPolicy(policy *networkingv1.NetworkPolicy, namespaces []string) *networkingv1.NetworkPolicy {
+	if len(policy.Spec.Ingress) == 0 {
+		return policy
+	}
+	for _, namespace := range namespaces {
+		policy.Spec.Ingress[0].From = append(policy.Spec.Ingress[0].From, networkingv1.NetworkPolicyPeer{
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"kubernetes.io/metadata.name": namespace,
+				},
+			},
+		})
+	}
+	return policy
+}
+
 func (c *TargetConfigReconciler) manageOpenshiftClusterRolesBindingForKueue(ctx context.Context, ownerReference metav1.OwnerReference) (*rbacv1.ClusterRoleBinding, bool, error) {
 	clusterRoleBinding := &rbacv1.ClusterRoleBinding{
 		ObjectMeta: metav1.ObjectMeta{
@@ -1706,7 +1682,7 @@ func (c *TargetConfigReconciler) manageCustomResources(ctx context.Context, spec
 		return fmt.Errorf("failed to read crd directory: %w", err)
 	}
 
-	var hash string
+	var steps []applyStep
 	for _, file := range files {
 		assetPath := filepath.Join(crdDir, file)
 		required := resourceread.ReadCustomResourceDefinitionV1OrDie(bindata.MustAsset(assetPath))
@@ -1735,17 +1711,22 @@ func (c *TargetConfigReconciler) manageCustomResources(ctx context.Context, spec
 			}
 		}
 
-		crd, _, err := c.applyCustomResourceDefinitionWithCache(ctx, required)
-		if err != nil {
-			return err
-		}
-		hash, err = computeSpecHash(crd.Spec)
-		if err != nil {
-			return fmt.Errorf("failed to hash CRD spec: %w", err)
-		}
-		specAnnotations["crd/"+crd.Name] = hash
+		steps = append(steps, func(ctx context.Context, specAnnotations map[string]string) error {
+			crd, _, err := c.applyCustomResourceDefinitionWithCache(ctx, required)
+			if err != nil {
+				return err
+			}
+			// The required spec is hashed, the applied CRD carries the defaults set by the
+			// API server only when it was not read from the cache.
+			hash, err := computeSpecHash(required.Spec)
+			if err != nil {
+				return fmt.Errorf("failed to hash CRD spec: %w", err)
+			}
+			specAnnotations["crd/"+crd.Name] = hash
+			return nil
+		})
 	}
-	return nil
+	return c.runApplyPhase(ctx, customResourceDefinitionsSubController, specAnnotations, steps...)
 }
 
 func (c *TargetConfigReconciler) manageDeployment(ctx context.Context, kueueoperator *kueuev1.Kueue, specAnnotations map[string]string, ownerReference metav1.OwnerReference) (*appsv1.Deployment, bool, error) {
@@ -1877,6 +1858,20 @@ func (c *TargetConfigReconciler) manageDeployment(ctx context.Context, kueueoper
 
 	resourcemerge.MergeMap(ptr.To(false), &required.Spec.Template.Annotations, specAnnotations)
 
+	// The spec hashes that changed since the last rollout tell which objects trigger the next one.
+	var rolloutTriggers []string
+	existing, err := c.kubeInformersForNamespaces.InformersFor(required.Namespace).Apps().V1().Deployments().Lister().Deployments(required.Namespace).Get(required.Name)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, false, err
+	}
+	if existing != nil {
+		for key, hash := range specAnnotations {
+			if existing.Spec.Template.Annotations[key] != hash {
+				rolloutTriggers = append(rolloutTriggers, key)
+			}
+		}
+	}
+
 	deploy, updated, err := c.applyDeploymentWithCache(ctx,
 		required,
 		resourcemerge.ExpectedDeploymentGeneration(required, kueueoperator.Status.Generations))
@@ -1887,6 +1882,9 @@ func (c *TargetConfigReconciler) manageDeployment(ctx context.Context, kueueoper
 	if updated {
 		klog.V(2).Infof("Deployment %s/%s was updated (generation: %d)", deploy.Namespace, deploy.Name, deploy.Generation)
 		resourcemerge.SetDeploymentGeneration(&kueueoperator.Status.Generations, deploy)
+		for _, trigger := range rolloutTriggers {
+			metrics.RecordOperandRollout(trigger)
+		}
 	} else {
 		klog.V(4).Infof("Deployment %s/%s unchanged (generation: %d)", required.Namespace, required.Name, required.Generation)
 	}
@@ -1970,14 +1968,24 @@ func (c *TargetConfigReconciler) manageCertificateCR(ctx context.Context, kueue
 }
 
 func (c *TargetConfigReconciler) manageServiceMonitor(ctx context.Context, kueue *kueuev1.Kueue) (*unstructured.Unstructured, bool, error) {
-	// Create ServiceMonitor object
-	serviceMonitor := monitoringv1.ServiceMonitor{
+	serviceMonitor := c.kueueServiceMonitor(kueue, "kueue-metrics")
+	required := &unstructured.Unstructured{}
+	if err := convertObj2Unstructured(serviceMonitor, required); err != nil {
+		return nil, false, err
+	}
+
+	return resourceapply.ApplyServiceMonitor(ctx, c.dynamicClient, c.eventRecorder, required)
+}
+
+// kueueServiceMonitor returns a ServiceMonitor scraping the metrics endpoint of Kueue.
+func (c *TargetConfigReconciler) kueueServiceMonitor(kueue *kueuev1.Kueue, name string) monitoringv1.ServiceMonitor {
+	return monitoringv1.ServiceMonitor{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "ServiceMonitor",
 			APIVersion: "monitoring.coreos.com/v1",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      "kueue-metrics",
+			Name:      name,
 			Namespace: c.operatorNamespace,
 			OwnerReferences: []metav1.OwnerReference{
 				{
@@ -1997,7 +2005,7 @@ func (c *TargetConfigReconciler) manageServiceMonitor(ctx context.Context, kueue
 			},
 			Endpoints: []monitoringv1.Endpoint{
 				{
-					Interval:        "30s",
+					Interval:        scrapeInterval(kueue.Spec.Config.Metrics),
 					Path:            "/metrics",
 					Port:            "https", // Name of the port you want to monitor
 					Scheme:          "https",
@@ -2027,26 +2035,140 @@ func (c *TargetConfigReconciler) manageServiceMonitor(ctx context.Context, kueue
 								},
 								Key: "tls.key",
 							},
-							ServerName: ptr.To("kueue-controller-manager-metrics-service.openshift-kueue-operator.svc"),
+							ServerName: ptr.To(fmt.Sprintf("kueue-controller-manager-metrics-service.%s.svc", c.operatorNamespace)),
 						},
 					},
 				},
 			},
 		},
 	}
-	required := &unstructured.Unstructured{}
-	convertObj2Unstructured(serviceMonitor, required)
+}
+
+// scrapeInterval returns the interval the metrics are scraped at, 30s by default.
+func scrapeInterval(metrics kueuev1.Metrics) monitoringv1.Duration {
+	if metrics.ScrapeInterval == "" {
+		return "30s"
+	}
+	return monitoringv1.Duration(metrics.ScrapeInterval)
+}
 
+// manageOperatorServiceMonitor scrapes the metrics of the operator itself. The operator
+// serves them with the certificate the service CA issues for its metrics Service, and
+// the scrapes authenticate with the token of Prometheus, authorized by library-go.
+func (c *TargetConfigReconciler) manageOperatorServiceMonitor(ctx context.Context, kueue *kueuev1.Kueue) (*unstructured.Unstructured, bool, error) {
+	required := &unstructured.Unstructured{}
+	if err := convertObj2Unstructured(c.operatorServiceMonitor(kueue), required); err != nil {
+		return nil, false, err
+	}
 	return resourceapply.ApplyServiceMonitor(ctx, c.dynamicClient, c.eventRecorder, required)
 }
 
+// operatorServiceMonitor returns the ServiceMonitor scraping the metrics endpoint of the
+// operator.
+func (c *TargetConfigReconciler) operatorServiceMonitor(kueue *kueuev1.Kueue) monitoringv1.ServiceMonitor {
+	return monitoringv1.ServiceMonitor{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ServiceMonitor",
+			APIVersion: "monitoring.coreos.com/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "kueue-operator-metrics",
+			Namespace: c.operatorNamespace,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: "kueue.openshift.io/v1",
+					Kind:       "Kueue",
+					Name:       kueue.Name,
+					UID:        kueue.UID,
+				},
+			},
+		},
+		Spec: monitoringv1.ServiceMonitorSpec{
+			Selector: metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"app.kubernetes.io/component": "operator-metrics-service",
+					"app.openshift.io/name":       "kueue",
+				},
+			},
+			Endpoints: []monitoringv1.Endpoint{
+				{
+					Interval:        scrapeInterval(kueue.Spec.Config.Metrics),
+					Path:            "/metrics",
+					Port:            "https",
+					Scheme:          "https",
+					BearerTokenFile: "/var/run/secrets/kubernetes.io/serviceaccount/token",
+					TLSConfig: &monitoringv1.TLSConfig{
+						SafeTLSConfig: monitoringv1.SafeTLSConfig{
+							InsecureSkipVerify: ptr.To(false),
+							CA: monitoringv1.SecretOrConfigMap{
+								ConfigMap: &v1.ConfigMapKeySelector{
+									LocalObjectReference: v1.LocalObjectReference{
+										Name: "openshift-service-ca.crt",
+									},
+									Key: "service-ca.crt",
+								},
+							},
+							ServerName: ptr.To(fmt.Sprintf("kueue-operator-metrics-service.%s.svc", c.operatorNamespace)),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// managePrometheusRule applies the alerts and recording rules of Kueue, and deletes them
+// when they are all disabled in the Kueue CR.
+func (c *TargetConfigReconciler) managePrometheusRule(ctx context.Context, monitoring kueuev1.Monitoring, ownerReference metav1.OwnerReference) (*unstructured.Unstructured, bool, error) {
+	rule := prometheusrule.Build(monitoring, c.operatorNamespace)
+	if rule == nil {
+		required := &unstructured.Unstructured{}
+		required.SetName(prometheusrule.Name)
+		required.SetNamespace(c.operatorNamespace)
+		return resourceapply.DeletePrometheusRule(ctx, c.dynamicClient, c.eventRecorder, required)
+	}
+	rule.OwnerReferences = []metav1.OwnerReference{ownerReference}
+	required := &unstructured.Unstructured{}
+	if err := convertObj2Unstructured(rule, required); err != nil {
+		return nil, false, err
+	}
+
+	return resourceapply.ApplyPrometheusRule(ctx, c.dynamicClient, c.eventRecorder, required)
+}
+
+// dashboardSteps returns the steps applying the dashboards of the OpenShift console, one
+// per dashboard. The console reads them from the ConfigMaps labeled as dashboards in
+// openshift-config-managed.
+func (c *TargetConfigReconciler) dashboardSteps(ownerReference metav1.OwnerReference) ([]applyStep, error) {
+	files, err := bindata.AssetDir(dashboardDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read dashboards from directory %q: %w", dashboardDir, err)
+	}
+
+	var steps []applyStep
+	for _, file := range files {
+		required := resourceread.ReadConfigMapV1OrDie(bindata.MustAsset(filepath.Join(dashboardDir, file)))
+		required.OwnerReferences = []metav1.OwnerReference{ownerReference}
+		setManagedByLabel(required)
+		steps = append(steps, func(ctx context.Context, specAnnotations map[string]string) error {
+			if _, _, err := resourceapply.ApplyConfigMapImproved(ctx, c.kubeClient.CoreV1(), c.eventRecorder, required, c.resourceCache); err != nil {
+				return fmt.Errorf("unable to manage dashboard %s: %w", required.Name, err)
+			}
+			return nil
+		})
+	}
+	return steps, nil
+}
+
 // applyClusterRoleWithCache wraps ApplyClusterRole with caching support to reduce API server calls
 // Uses informer lister to get cached data instead of live GET calls
 func (c *TargetConfigReconciler) applyClusterRoleWithCache(ctx context.Context,
 	required *rbacv1.ClusterRole,
 ) (*rbacv1.ClusterRole, bool, error) {
+	setManagedByLabel(required)
+
 	// Try to get existing resource from informer cache (no API call!)
-	existing, err := c.kubeInformer.Rbac().V1().ClusterRoles().Lister().Get(required.Name)
+	existing, err := c.managedInformer.Rbac().V1().ClusterRoles().Lister().Get(required.Name)
 	if err != nil && !errors.IsNotFound(err) {
 		return nil, false, err
 	}
@@ -2078,8 +2200,10 @@ func (c *TargetConfigReconciler) applyClusterRoleWithCache(ctx context.Context,
 func (c *TargetConfigReconciler) applyClusterRoleBindingWithCache(ctx context.Context,
 	required *rbacv1.ClusterRoleBinding,
 ) (*rbacv1.ClusterRoleBinding, bool, error) {
+	setManagedByLabel(required)
+
 	// Try to get existing resource from informer cache (no API call!)
-	existing, err := c.kubeInformer.Rbac().V1().ClusterRoleBindings().Lister().Get(required.Name)
+	existing, err := c.managedInformer.Rbac().V1().ClusterRoleBindings().Lister().Get(required.Name)
 	if err != nil && !errors.IsNotFound(err) {
 		return nil, false, err
 	}
@@ -2111,8 +2235,10 @@ func (c *TargetConfigReconciler) applyClusterRoleBindingWithCache(ctx context.Co
 func (c *TargetConfigReconciler) applyRoleWithCache(ctx context.Context,
 	required *rbacv1.Role,
 ) (*rbacv1.Role, bool, error) {
+	setManagedByLabel(required)
+
 	// Try to get existing resource from informer cache (no API call!)
-	existing, err := c.kubeInformer.Rbac().V1().Roles().Lister().Roles(required.Namespace).Get(required.Name)
+	existing, err := c.managedInformer.Rbac().V1().Roles().Lister().Roles(required.Namespace).Get(required.Name)
 	if err != nil && !errors.IsNotFound(err) {
 		return nil, false, err
 	}
@@ -2145,8 +2271,10 @@ func (c *TargetConfigReconciler) applyRoleWithCache(ctx context.Context,
 func (c *TargetConfigReconciler) applyRoleBindingWithCache(ctx context.Context,
 	required *rbacv1.RoleBinding,
 ) (*rbacv1.RoleBinding, bool, error) {
+	setManagedByLabel(required)
+
 	// Try to get existing resource from informer cache (no API call!)
-	existing, err := c.kubeInformer.Rbac().V1().RoleBindings().Lister().RoleBindings(required.Namespace).Get(required.Name)
+	existing, err := c.managedInformer.Rbac().V1().RoleBindings().Lister().RoleBindings(required.Namespace).Get(required.Name)
 	if err != nil && !errors.IsNotFound(err) {
 		return nil, false, err
 	}
@@ -2213,8 +2341,10 @@ func (c *TargetConfigReconciler) applyNetworkPolicyWithCache(ctx context.Context
 func (c *TargetConfigReconciler) applyCustomResourceDefinitionWithCache(ctx context.Context,
 	required *apiextensionsv1.CustomResourceDefinition,
 ) (*apiextensionsv1.CustomResourceDefinition, bool, error) {
+	setManagedByLabel(required)
+
 	// Try to get existing resource from informer cache (no API call!)
-	existing, err := c.crdInformer.Apiextensions().V1().CustomResourceDefinitions().Lister().Get(required.Name)
+	existing, err := c.managedCRDInformer.Apiextensions().V1().CustomResourceDefinitions().Lister().Get(required.Name)
 	if err != nil && !errors.IsNotFound(err) {
 		return nil, false, err
 	}
@@ -2253,15 +2383,6 @@ func (c *TargetConfigReconciler) applyCustomResourceDefinitionWithCache(ctx cont
 	return crd, updated, err
 }
 
-// eventHandler queues the operator to check spec and status
-func (c *TargetConfigReconciler) eventHandler(item queueItem) cache.ResourceEventHandler {
-	return cache.ResourceEventHandlerFuncs{
-		AddFunc:    func(obj interface{}) { c.queue.Add(item) },
-		UpdateFunc: func(old, new interface{}) { c.queue.Add(item) },
-		DeleteFunc: func(obj interface{}) { c.queue.Add(item) },
-	}
-}
-
 func isResourceRegistered(discoveryClient discovery.DiscoveryInterface, gvk schema.GroupVersionKind) (bool, error) {
 	apiResourceLists, err := discoveryClient.ServerResourcesForGroupVersion(gvk.GroupVersion().String())
 	if err != nil {
@@ -2287,15 +2408,24 @@ func (c *TargetConfigReconciler) isResourceRegisteredCached(gvk schema.GroupVers
 	plural := strings.ToLower(gvk.Kind) + "s"
 	crdName := plural + "." + gvk.Group
 
-	// Check if CRD exists in the informer cache
-	_, err := c.crdInformer.Apiextensions().V1().CustomResourceDefinitions().Lister().Get(crdName)
+	crd, err := c.getCustomResourceDefinitionCached(crdName)
+	if err != nil {
+		return false, err
+	}
+	return crd != nil, nil
+}
+
+// getCustomResourceDefinitionCached returns the CRD with the given name from the CRD
+// informer cache, or nil if it is not installed.
+func (c *TargetConfigReconciler) getCustomResourceDefinitionCached(name string) (*apiextensionsv1.CustomResourceDefinition, error) {
+	crd, err := c.crdInformer.Apiextensions().V1().CustomResourceDefinitions().Lister().Get(name)
 	if err != nil {
 		if errors.IsNotFound(err) {
-			return false, nil
+			return nil, nil
 		}
-		return false, err
+		return nil, err
 	}
-	return true, nil
+	return crd, nil
 }
 
 // detectOpenShift detects whether the operator is running on OpenShift or vanilla Kubernetes (kind, etc.).
@@ -2378,8 +2508,10 @@ func (c *TargetConfigReconciler) applyDeploymentWithCache(ctx context.Context,
 func (c *TargetConfigReconciler) applyPriorityLevelConfigurationWithCache(ctx context.Context,
 	required *flowcontrolv1.PriorityLevelConfiguration,
 ) (*flowcontrolv1.PriorityLevelConfiguration, bool, error) {
+	setManagedByLabel(required)
+
 	// Try to get existing resource from informer cache (no API call!)
-	existing, err := c.kubeInformer.Flowcontrol().V1().PriorityLevelConfigurations().Lister().Get(required.Name)
+	existing, err := c.managedInformer.Flowcontrol().V1().PriorityLevelConfigurations().Lister().Get(required.Name)
 	if err != nil && !errors.IsNotFound(err) {
 		return nil, false, err
 	}
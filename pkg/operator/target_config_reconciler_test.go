@@ -0,0 +1,170 @@
+package operator
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/openshift/kueue-operator/bindata"
+	kueuev1 "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+	"github.com/openshift/library-go/pkg/operator/events"
+	"github.com/openshift/library-go/pkg/operator/resource/resourceapply"
+	"github.com/openshift/library-go/pkg/operator/resource/resourceread"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apiextfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	apiextinformer "k8s.io/apiextensions-apiserver/pkg/client/informers/externalversions"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+)
+
+func TestOperatorServiceMonitor(t *testing.T) {
+	const namespace = "openshift-kueue-operator"
+	c := &TargetConfigReconciler{operatorNamespace: namespace}
+	service := resourceread.ReadServiceV1OrDie(bindata.MustAsset("assets/kueue-operator/operator-metrics-service.yaml"))
+
+	serviceMonitor := c.operatorServiceMonitor(&kueuev1.Kueue{})
+
+	if !labels.SelectorFromSet(serviceMonitor.Spec.Selector.MatchLabels).Matches(labels.Set(service.Labels)) {
+		t.Errorf("The ServiceMonitor selector %v does not select the operator metrics Service labeled %v", serviceMonitor.Spec.Selector.MatchLabels, service.Labels)
+	}
+	if service.Annotations["service.beta.openshift.io/serving-cert-secret-name"] == "" {
+		t.Errorf("The operator metrics Service does not request a serving certificate: %v", service.Annotations)
+	}
+	if len(serviceMonitor.Spec.Endpoints) != 1 {
+		t.Fatalf("Unexpected endpoints: %v", serviceMonitor.Spec.Endpoints)
+	}
+	wantTLSConfig := &monitoringv1.TLSConfig{
+		SafeTLSConfig: monitoringv1.SafeTLSConfig{
+			InsecureSkipVerify: ptr.To(false),
+			CA: monitoringv1.SecretOrConfigMap{
+				ConfigMap: &corev1.ConfigMapKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "openshift-service-ca.crt"},
+					Key:                  "service-ca.crt",
+				},
+			},
+			ServerName: ptr.To(fmt.Sprintf("%s.%s.svc", service.Name, namespace)),
+		},
+	}
+	if diff := cmp.Diff(wantTLSConfig, serviceMonitor.Spec.Endpoints[0].TLSConfig); diff != "" {
+		t.Errorf("Unexpected TLS configuration (-want,+got):\n%s", diff)
+	}
+}
+
+func TestDeleteClusterRoleIfExistsLooksUpLegacyRolesOnce(t *testing.T) {
+	ctx := context.Background()
+	// The ClusterRole was applied without the managed-by label, it is not cached.
+	kubeClient := fake.NewClientset(&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "legacy"}})
+	c := &TargetConfigReconciler{
+		kubeClient:        kubeClient,
+		managedInformer:   informers.NewSharedInformerFactory(kubeClient, 0),
+		legacyRBACLookups: sets.New[string](),
+	}
+	gets := func() int {
+		count := 0
+		for _, action := range kubeClient.Actions() {
+			if action.Matches("get", "clusterroles") {
+				count++
+			}
+		}
+		return count
+	}
+
+	for _, name := range []string{"legacy", "missing"} {
+		if err := c.deleteClusterRoleIfExists(ctx, name); err != nil {
+			t.Fatalf("deleteClusterRoleIfExists(%s) failed: %v", name, err)
+		}
+	}
+	if got := gets(); got != 2 {
+		t.Errorf("Unexpected first lookups: got %d, want 2", got)
+	}
+	if _, err := kubeClient.RbacV1().ClusterRoles().Get(ctx, "legacy", metav1.GetOptions{}); err == nil {
+		t.Errorf("The legacy ClusterRole is not deleted")
+	}
+	kubeClient.ClearActions()
+
+	for _, name := range []string{"legacy", "missing"} {
+		if err := c.deleteClusterRoleIfExists(ctx, name); err != nil {
+			t.Fatalf("deleteClusterRoleIfExists(%s) failed: %v", name, err)
+		}
+	}
+	if actions := kubeClient.Actions(); len(actions) != 0 {
+		t.Errorf("Unexpected actions once the ClusterRoles were looked up: %v", actions)
+	}
+}
+
+func TestSyncMonitoringDashboards(t *testing.T) {
+	const (
+		namespace = "openshift-kueue-operator"
+		// dashboardNamespace is where the console reads the dashboards from.
+		dashboardNamespace = "openshift-config-managed"
+	)
+	files, err := bindata.AssetDir(dashboardDir)
+	if err != nil {
+		t.Fatalf("failed to read the dashboards: %v", err)
+	}
+	dashboards := sets.New[string]()
+	for _, file := range files {
+		dashboards.Insert(resourceread.ReadConfigMapV1OrDie(bindata.MustAsset(filepath.Join(dashboardDir, file))).Name)
+	}
+
+	for _, isOpenShift := range []bool{true, false} {
+		t.Run(fmt.Sprintf("isOpenShift=%t", isOpenShift), func(t *testing.T) {
+			ctx := context.Background()
+			kubeClient := fake.NewClientset()
+			c := &TargetConfigReconciler{
+				kubeClient:        kubeClient,
+				crdInformer:       apiextinformer.NewSharedInformerFactory(apiextfake.NewClientset(), 0),
+				operatorNamespace: namespace,
+				eventRecorder:     events.NewInMemoryRecorder("test", clock.RealClock{}),
+				resourceCache:     resourceapply.NewResourceCache(),
+				applySlots:        make(chan struct{}, maxConcurrentApplies),
+				isOpenShift:       isOpenShift,
+			}
+			listDashboards := func() []corev1.ConfigMap {
+				configMaps, err := kubeClient.CoreV1().ConfigMaps(dashboardNamespace).List(ctx, metav1.ListOptions{})
+				if err != nil {
+					t.Fatalf("failed to list the ConfigMaps of %s: %v", dashboardNamespace, err)
+				}
+				return configMaps.Items
+			}
+
+			state := &operandState{kueue: &kueuev1.Kueue{}}
+			if _, err := c.syncMonitoring(ctx, state, map[string]string{}); err != nil {
+				t.Fatalf("syncMonitoring() failed: %v", err)
+			}
+			want := sets.New[string]()
+			if isOpenShift {
+				want = dashboards
+			}
+			got := sets.New[string]()
+			for _, configMap := range listDashboards() {
+				got.Insert(configMap.Name)
+				if configMap.Labels["console.openshift.io/dashboard"] != "true" {
+					t.Errorf("The dashboard %s is not labeled for the console: %v", configMap.Name, configMap.Labels)
+				}
+				if configMap.Labels[kueuev1.ManagedByLabel] != kueuev1.ManagedByValue {
+					t.Errorf("The dashboard %s is not labeled as managed by the operator: %v", configMap.Name, configMap.Labels)
+				}
+			}
+			if diff := cmp.Diff(sets.List(want), sets.List(got)); diff != "" {
+				t.Errorf("Unexpected dashboards in %s (-want,+got):\n%s", dashboardNamespace, diff)
+			}
+
+			if err := c.cleanUpDashboards(ctx); err != nil {
+				t.Fatalf("cleanUpDashboards() failed: %v", err)
+			}
+			if remaining := listDashboards(); len(remaining) != 0 {
+				t.Errorf("Unexpected dashboards after the clean up: %v", remaining)
+			}
+		})
+	}
+}
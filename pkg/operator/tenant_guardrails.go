@@ -0,0 +1,77 @@
+package operator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	applyoperatorv1 "github.com/openshift/client-go/operator/applyconfigurations/operator/v1"
+	kueuev1 "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+	"github.com/openshift/kueue-operator/pkg/queueassignment"
+	"github.com/openshift/library-go/pkg/operator/resource/resourceapply"
+	utilerror "k8s.io/apimachinery/pkg/util/errors"
+)
+
+const tenantGuardrailsReadyConditionType = "TenantGuardrailsReady"
+
+// manageTenantGuardrails enforces the tenant guardrails with a ValidatingAdmissionPolicy
+// and reports whether the API server type checked the policy without warnings. The
+// policy is deleted, and nil is returned, when no guardrail is configured.
+func (c *TargetConfigReconciler) manageTenantGuardrails(ctx context.Context, kueue *kueuev1.Kueue, kueueCfg kueuev1.KueueConfiguration) (*applyoperatorv1.OperatorConditionApplyConfiguration, error) {
+	rules := kueue.Spec.Queues.TenantGuardrails.Rules
+	if len(rules) == 0 {
+		return nil, c.cleanUpTenantGuardrails(ctx)
+	}
+
+	policy, binding := queueassignment.BuildValidatingAdmissionPolicy(rules, queueassignment.Resources(kueueCfg), c.operatorNamespace)
+	setManagedByLabel(policy)
+	setManagedByLabel(binding)
+	current, _, err := resourceapply.ApplyValidatingAdmissionPolicyV1(ctx, c.kubeClient.AdmissionregistrationV1(), c.eventRecorder, policy, c.resourceCache)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply ValidatingAdmissionPolicy %s: %w", policy.Name, err)
+	}
+	if _, _, err := resourceapply.ApplyValidatingAdmissionPolicyBindingV1(ctx, c.kubeClient.AdmissionregistrationV1(), c.eventRecorder, binding, c.resourceCache); err != nil {
+		return nil, fmt.Errorf("failed to apply ValidatingAdmissionPolicyBinding %s: %w", binding.Name, err)
+	}
+
+	condition := applyoperatorv1.OperatorCondition().WithType(tenantGuardrailsReadyConditionType)
+	switch {
+	case current.Status.ObservedGeneration < current.Generation:
+		// The policy is enforced while it is type checked, the condition is
+		// updated on the next resync.
+		return condition.
+			WithStatus(operatorv1.ConditionTrue).
+			WithReason("TypeCheckPending").
+			WithMessage(fmt.Sprintf("%d tenant guardrail rules are enforced by ValidatingAdmissionPolicy %s, type checking is pending", len(rules), policy.Name)), nil
+	case current.Status.TypeChecking != nil && len(current.Status.TypeChecking.ExpressionWarnings) > 0:
+		warnings := []string{}
+		for _, warning := range current.Status.TypeChecking.ExpressionWarnings {
+			warnings = append(warnings, fmt.Sprintf("%s: %s", warning.FieldRef, warning.Warning))
+		}
+		return condition.
+			WithStatus(operatorv1.ConditionFalse).
+			WithReason("TypeCheckWarnings").
+			WithMessage(fmt.Sprintf("ValidatingAdmissionPolicy %s has type checking warnings: %s", policy.Name, strings.Join(warnings, "; "))), nil
+	default:
+		return condition.
+			WithStatus(operatorv1.ConditionTrue).
+			WithReason("AsExpected").
+			WithMessage(fmt.Sprintf("%d tenant guardrail rules are enforced by ValidatingAdmissionPolicy %s", len(rules), policy.Name)), nil
+	}
+}
+
+// cleanUpTenantGuardrails deletes the ValidatingAdmissionPolicy of the tenant guardrails
+// and its binding.
+func (c *TargetConfigReconciler) cleanUpTenantGuardrails(ctx context.Context) error {
+	var errorList []error
+	client := c.kubeClient.AdmissionregistrationV1()
+	informers := c.managedInformer.Admissionregistration().V1()
+	if err := deleteCachedObject(ctx, informers.ValidatingAdmissionPolicyBindings().Lister(), client.ValidatingAdmissionPolicyBindings().Delete, queueassignment.GuardrailsPolicyName); err != nil {
+		errorList = append(errorList, fmt.Errorf("failed to delete ValidatingAdmissionPolicyBinding %s: %w", queueassignment.GuardrailsPolicyName, err))
+	}
+	if err := deleteCachedObject(ctx, informers.ValidatingAdmissionPolicies().Lister(), client.ValidatingAdmissionPolicies().Delete, queueassignment.GuardrailsPolicyName); err != nil {
+		errorList = append(errorList, fmt.Errorf("failed to delete ValidatingAdmissionPolicy %s: %w", queueassignment.GuardrailsPolicyName, err))
+	}
+	return utilerror.NewAggregate(errorList)
+}
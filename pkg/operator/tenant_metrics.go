@@ -0,0 +1,191 @@
+package operator
+
+import (
+	"context"
+	"fmt"
+
+	kueuev1 "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+	"github.com/openshift/library-go/pkg/operator/resource/resourceapply"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilerror "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// tenantMetricsReaderName is the ClusterRole, and its binding, authorizing the
+	// Prometheus of the monitoring stack to scrape the LocalQueue metrics of Kueue.
+	tenantMetricsReaderName = "kueue-local-queue-metrics-reader"
+	// tenantMetricsNetworkPolicyName is the NetworkPolicy admitting the scrapes of the
+	// LocalQueue metrics.
+	tenantMetricsNetworkPolicyName = "kueue-allow-ingress-local-queue-metrics"
+	// monitoringNamespace is the namespace of the Prometheus scraping the ServiceMonitors
+	// of the operator namespace.
+	monitoringNamespace = "openshift-monitoring"
+)
+
+var serviceMonitorGVR = schema.GroupVersionResource{
+	Group:    "monitoring.coreos.com",
+	Version:  "v1",
+	Resource: "servicemonitors",
+}
+
+// manageTenantMetrics exposes the LocalQueue metrics of Kueue to the users of their
+// namespace: the ServiceMonitor scraping them, the RBAC authorizing the Prometheus of the
+// monitoring stack to scrape Kueue and the NetworkPolicy admitting its scrapes. They are
+// deleted when the tenant LocalQueue metrics are disabled.
+func (c *TargetConfigReconciler) manageTenantMetrics(ctx context.Context, kueue *kueuev1.Kueue, ownerReference metav1.OwnerReference) error {
+	if kueue.Spec.Config.Metrics.TenantLocalQueueMetrics != kueuev1.MetricsStateEnabled {
+		return c.cleanUpTenantMetrics(ctx)
+	}
+
+	clusterRole, clusterRoleBinding := tenantMetricsRBAC(ownerReference)
+	if _, _, err := c.applyClusterRoleWithCache(ctx, clusterRole); err != nil {
+		return fmt.Errorf("failed to apply ClusterRole %s: %w", clusterRole.Name, err)
+	}
+	if _, _, err := c.applyClusterRoleBindingWithCache(ctx, clusterRoleBinding); err != nil {
+		return fmt.Errorf("failed to apply ClusterRoleBinding %s: %w", clusterRoleBinding.Name, err)
+	}
+	policy := c.tenantMetricsNetworkPolicy(ownerReference)
+	if _, _, err := c.applyNetworkPolicyWithCache(ctx, policy); err != nil {
+		return fmt.Errorf("failed to apply NetworkPolicy %s: %w", policy.Name, err)
+	}
+	if _, _, err := c.manageTenantServiceMonitor(ctx, kueue); err != nil {
+		return fmt.Errorf("failed to apply ServiceMonitor %s: %w", tenantServiceMonitorName, err)
+	}
+	return nil
+}
+
+// manageTenantServiceMonitor scrapes the LocalQueue metrics of Kueue keeping their
+// namespace label, which is the namespace of their LocalQueue, so that the users of the
+// namespace can query them through the tenancy of the monitoring stack.
+func (c *TargetConfigReconciler) manageTenantServiceMonitor(ctx context.Context, kueue *kueuev1.Kueue) (*unstructured.Unstructured, bool, error) {
+	serviceMonitor := c.kueueServiceMonitor(kueue, tenantServiceMonitorName)
+	endpoint := &serviceMonitor.Spec.Endpoints[0]
+	endpoint.HonorLabels = true
+	endpoint.MetricRelabelConfigs = []monitoringv1.RelabelConfig{
+		{
+			SourceLabels: []monitoringv1.LabelName{"__name__"},
+			Regex:        "kueue_local_queue_.+",
+			Action:       "keep",
+		},
+	}
+	required := &unstructured.Unstructured{}
+	if err := convertObj2Unstructured(serviceMonitor, required); err != nil {
+		return nil, false, err
+	}
+
+	return resourceapply.ApplyServiceMonitor(ctx, c.dynamicClient, c.eventRecorder, required)
+}
+
+// tenantMetricsRBAC returns the ClusterRole and ClusterRoleBinding authorizing the
+// Prometheus of the monitoring stack to read the metrics endpoint of Kueue.
+func tenantMetricsRBAC(ownerReference metav1.OwnerReference) (*rbacv1.ClusterRole, *rbacv1.ClusterRoleBinding) {
+	clusterRole := &rbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            tenantMetricsReaderName,
+			OwnerReferences: []metav1.OwnerReference{ownerReference},
+		},
+		Rules: []rbacv1.PolicyRule{
+			{
+				NonResourceURLs: []string{"/metrics"},
+				Verbs:           []string{"get"},
+			},
+		},
+	}
+	clusterRoleBinding := &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            tenantMetricsReaderName,
+			OwnerReferences: []metav1.OwnerReference{ownerReference},
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     tenantMetricsReaderName,
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      rbacv1.ServiceAccountKind,
+				Name:      "prometheus-k8s",
+				Namespace: monitoringNamespace,
+			},
+		},
+	}
+	return clusterRole, clusterRoleBinding
+}
+
+// tenantMetricsNetworkPolicy returns the NetworkPolicy admitting the scrapes of the
+// metrics endpoint of Kueue from the Prometheus of the monitoring stack. It does not
+// depend on the metrics policy, whose namespaces are configurable.
+func (c *TargetConfigReconciler) tenantMetricsNetworkPolicy(ownerReference metav1.OwnerReference) *networkingv1.NetworkPolicy {
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            tenantMetricsNetworkPolicyName,
+			Namespace:       c.operatorNamespace,
+			OwnerReferences: []metav1.OwnerReference{ownerReference},
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"app.kubernetes.io/name": "kueue",
+					"control-plane":          "controller-manager",
+				},
+			},
+			Ingress: []networkingv1.NetworkPolicyIngressRule{
+				{
+					From: []networkingv1.NetworkPolicyPeer{
+						{
+							NamespaceSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"kubernetes.io/metadata.name": monitoringNamespace},
+							},
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"app.kubernetes.io/name": "prometheus"},
+							},
+						},
+					},
+					Ports: []networkingv1.NetworkPolicyPort{
+						{
+							Protocol: ptr.To(corev1.ProtocolTCP),
+							Port:     ptr.To(intstr.FromInt32(8443)),
+						},
+					},
+				},
+			},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+		},
+	}
+}
+
+// cleanUpTenantMetrics deletes the objects exposing the tenant LocalQueue metrics when
+// they are cached, so that the syncs do not call the API server while the tenant
+// LocalQueue metrics stay disabled.
+func (c *TargetConfigReconciler) cleanUpTenantMetrics(ctx context.Context) error {
+	var errorList []error
+	if c.monitoringInformer != nil {
+		lister := c.monitoringInformer.ForResource(serviceMonitorGVR).Lister().ByNamespace(c.operatorNamespace)
+		deleteFunc := func(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+			return c.dynamicClient.Resource(serviceMonitorGVR).Namespace(c.operatorNamespace).Delete(ctx, name, opts)
+		}
+		if err := deleteCachedObject(ctx, lister, deleteFunc, tenantServiceMonitorName); err != nil {
+			errorList = append(errorList, fmt.Errorf("failed to delete ServiceMonitor %s: %w", tenantServiceMonitorName, err))
+		}
+	}
+	policies := c.kubeInformersForNamespaces.InformersFor(c.operatorNamespace).Networking().V1().NetworkPolicies().Lister().NetworkPolicies(c.operatorNamespace)
+	if err := deleteCachedObject(ctx, policies, c.kubeClient.NetworkingV1().NetworkPolicies(c.operatorNamespace).Delete, tenantMetricsNetworkPolicyName); err != nil {
+		errorList = append(errorList, fmt.Errorf("failed to delete NetworkPolicy %s: %w", tenantMetricsNetworkPolicyName, err))
+	}
+	rbac := c.managedInformer.Rbac().V1()
+	if err := deleteCachedObject(ctx, rbac.ClusterRoleBindings().Lister(), c.kubeClient.RbacV1().ClusterRoleBindings().Delete, tenantMetricsReaderName); err != nil {
+		errorList = append(errorList, fmt.Errorf("failed to delete ClusterRoleBinding %s: %w", tenantMetricsReaderName, err))
+	}
+	if err := deleteCachedObject(ctx, rbac.ClusterRoles().Lister(), c.kubeClient.RbacV1().ClusterRoles().Delete, tenantMetricsReaderName); err != nil {
+		errorList = append(errorList, fmt.Errorf("failed to delete ClusterRole %s: %w", tenantMetricsReaderName, err))
+	}
+	return utilerror.NewAggregate(errorList)
+}
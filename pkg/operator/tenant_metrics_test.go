@@ -0,0 +1,152 @@
+package operator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	kueuev1 "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+	"github.com/openshift/library-go/pkg/operator/events"
+	"github.com/openshift/library-go/pkg/operator/resource/resourceapply"
+	"github.com/openshift/library-go/pkg/operator/v1helpers"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestManageTenantMetrics(t *testing.T) {
+	const namespace = "openshift-kueue-operator"
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	kubeClient := fake.NewClientset()
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		serviceMonitorGVR: "ServiceMonitorList",
+	})
+	c := &TargetConfigReconciler{
+		kubeClient:                 kubeClient,
+		dynamicClient:              dynamicClient,
+		eventRecorder:              events.NewInMemoryRecorder("test", clocktesting.NewFakePassiveClock(time.Now())),
+		kubeInformersForNamespaces: v1helpers.NewKubeInformersForNamespaces(kubeClient, namespace),
+		managedInformer:            informers.NewSharedInformerFactory(kubeClient, 0),
+		monitoringInformer:         dynamicinformer.NewFilteredDynamicSharedInformerFactory(dynamicClient, 0, namespace, nil),
+		operatorNamespace:          namespace,
+		resourceCache:              resourceapply.NewResourceCache(),
+	}
+	serviceMonitors := c.monitoringInformer.ForResource(serviceMonitorGVR).Lister().ByNamespace(namespace)
+	networkPolicies := c.kubeInformersForNamespaces.InformersFor(namespace).Networking().V1().NetworkPolicies().Lister().NetworkPolicies(namespace)
+	clusterRoles := c.managedInformer.Rbac().V1().ClusterRoles().Lister()
+	clusterRoleBindings := c.managedInformer.Rbac().V1().ClusterRoleBindings().Lister()
+	c.kubeInformersForNamespaces.Start(ctx.Done())
+	c.managedInformer.Start(ctx.Done())
+	c.monitoringInformer.Start(ctx.Done())
+	c.kubeInformersForNamespaces.InformersFor(namespace).WaitForCacheSync(ctx.Done())
+	c.managedInformer.WaitForCacheSync(ctx.Done())
+	c.monitoringInformer.WaitForCacheSync(ctx.Done())
+
+	// cached returns whether each object exposing the tenant metrics is cached.
+	cached := func() []bool {
+		_, serviceMonitorErr := serviceMonitors.Get(tenantServiceMonitorName)
+		_, networkPolicyErr := networkPolicies.Get(tenantMetricsNetworkPolicyName)
+		_, clusterRoleErr := clusterRoles.Get(tenantMetricsReaderName)
+		_, clusterRoleBindingErr := clusterRoleBindings.Get(tenantMetricsReaderName)
+		return []bool{serviceMonitorErr == nil, networkPolicyErr == nil, clusterRoleErr == nil, clusterRoleBindingErr == nil}
+	}
+	waitForCache := func(want bool) {
+		t.Helper()
+		err := wait.PollUntilContextTimeout(ctx, 10*time.Millisecond, 5*time.Second, true, func(context.Context) (bool, error) {
+			for _, got := range cached() {
+				if got != want {
+					return false, nil
+				}
+			}
+			return true, nil
+		})
+		if err != nil {
+			t.Fatalf("The tenant metrics objects are not cached as %t: %v", want, cached())
+		}
+	}
+	// requests returns the requests other than the ones of the informers.
+	requests := func() []clienttesting.Action {
+		var actions []clienttesting.Action
+		for _, action := range append(kubeClient.Actions(), dynamicClient.Actions()...) {
+			if verb := action.GetVerb(); verb != "list" && verb != "watch" {
+				actions = append(actions, action)
+			}
+		}
+		kubeClient.ClearActions()
+		dynamicClient.ClearActions()
+		return actions
+	}
+	kueue := func(state kueuev1.MetricsState) *kueuev1.Kueue {
+		kueue := &kueuev1.Kueue{ObjectMeta: metav1.ObjectMeta{Name: "cluster"}}
+		kueue.Spec.Config.Metrics.TenantLocalQueueMetrics = state
+		return kueue
+	}
+
+	// Disabled, nothing is cached and the API server is not called.
+	if err := c.manageTenantMetrics(ctx, kueue(kueuev1.MetricsStateDisabled), metav1.OwnerReference{}); err != nil {
+		t.Fatalf("manageTenantMetrics() failed while disabled: %v", err)
+	}
+	if actions := requests(); len(actions) != 0 {
+		t.Errorf("Unexpected requests while the tenant metrics stay disabled: %v", actions)
+	}
+
+	if err := c.manageTenantMetrics(ctx, kueue(kueuev1.MetricsStateEnabled), metav1.OwnerReference{}); err != nil {
+		t.Fatalf("manageTenantMetrics() failed while enabled: %v", err)
+	}
+	serviceMonitor, err := dynamicClient.Resource(serviceMonitorGVR).Namespace(namespace).Get(ctx, tenantServiceMonitorName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("The ServiceMonitor is not applied: %v", err)
+	}
+	endpoints, _, _ := unstructured.NestedSlice(serviceMonitor.Object, "spec", "endpoints")
+	if len(endpoints) != 1 || endpoints[0].(map[string]interface{})["honorLabels"] != true {
+		t.Errorf("The ServiceMonitor does not honor the labels of the LocalQueue metrics: %v", endpoints)
+	}
+	clusterRoleBinding, err := kubeClient.RbacV1().ClusterRoleBindings().Get(ctx, tenantMetricsReaderName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("The ClusterRoleBinding is not applied: %v", err)
+	}
+	if clusterRoleBinding.Labels[kueuev1.ManagedByLabel] != kueuev1.ManagedByValue {
+		t.Errorf("The ClusterRoleBinding is not labeled as managed by the operator: %v", clusterRoleBinding.Labels)
+	}
+	if _, err := kubeClient.NetworkingV1().NetworkPolicies(namespace).Get(ctx, tenantMetricsNetworkPolicyName, metav1.GetOptions{}); err != nil {
+		t.Errorf("The NetworkPolicy is not applied: %v", err)
+	}
+	waitForCache(true)
+	requests()
+
+	// Disabled again, the cached objects are deleted.
+	if err := c.manageTenantMetrics(ctx, kueue(kueuev1.MetricsStateDisabled), metav1.OwnerReference{}); err != nil {
+		t.Fatalf("manageTenantMetrics() failed once disabled: %v", err)
+	}
+	if _, err := dynamicClient.Resource(serviceMonitorGVR).Namespace(namespace).Get(ctx, tenantServiceMonitorName, metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		t.Errorf("The ServiceMonitor is not deleted: %v", err)
+	}
+	if _, err := kubeClient.NetworkingV1().NetworkPolicies(namespace).Get(ctx, tenantMetricsNetworkPolicyName, metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		t.Errorf("The NetworkPolicy is not deleted: %v", err)
+	}
+	if _, err := kubeClient.RbacV1().ClusterRoles().Get(ctx, tenantMetricsReaderName, metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		t.Errorf("The ClusterRole is not deleted: %v", err)
+	}
+	if _, err := kubeClient.RbacV1().ClusterRoleBindings().Get(ctx, tenantMetricsReaderName, metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		t.Errorf("The ClusterRoleBinding is not deleted: %v", err)
+	}
+	waitForCache(false)
+	requests()
+
+	if err := c.manageTenantMetrics(ctx, kueue(kueuev1.MetricsStateDisabled), metav1.OwnerReference{}); err != nil {
+		t.Fatalf("manageTenantMetrics() failed while disabled: %v", err)
+	}
+	if actions := requests(); len(actions) != 0 {
+		t.Errorf("Unexpected requests once the tenant metrics objects are deleted: %v", actions)
+	}
+}
@@ -0,0 +1,372 @@
+package operator
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	applyoperatorv1 "github.com/openshift/client-go/operator/applyconfigurations/operator/v1"
+	"github.com/openshift/kueue-operator/bindata"
+	kueuev1 "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+	kueueconfigclient "github.com/openshift/kueue-operator/pkg/generated/clientset/versioned/typed/kueueoperator/v1"
+	"github.com/openshift/kueue-operator/pkg/integration"
+	"github.com/openshift/kueue-operator/pkg/operator/operatorclient"
+	"github.com/openshift/kueue-operator/pkg/queues"
+	"github.com/openshift/kueue-operator/pkg/uninstall"
+	"github.com/openshift/library-go/pkg/controller/factory"
+	"github.com/openshift/library-go/pkg/operator/events"
+	"github.com/openshift/library-go/pkg/operator/resource/resourceapply"
+	"github.com/openshift/library-go/pkg/operator/resource/resourcehelper"
+	"github.com/openshift/library-go/pkg/operator/resource/resourceread"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	utilerror "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/klog/v2"
+)
+
+const (
+	uninstallReadyConditionType = "UninstallReady"
+	uninstallFieldManager       = "kueue-operator-uninstall"
+	// uninstallPreflightConditionType is the condition reporting the workloads an
+	// uninstallation would affect.
+	uninstallPreflightConditionType = "UninstallPreflight"
+	uninstallPreflightFieldManager  = "kueue-operator-uninstall-preflight"
+	// kueuePodSelector selects the pods of the Kueue deployment.
+	kueuePodSelector = "control-plane=controller-manager"
+)
+
+// pendingWorkloads returns the Workloads waiting for admission, and the pods gated by
+// Kueue. No Workload is returned when the Workload API is not installed.
+func (c *TargetConfigReconciler) pendingWorkloads(ctx context.Context) ([]unstructured.Unstructured, []corev1.Pod, error) {
+	pendingWorkloads := []unstructured.Unstructured{}
+	workloads, err := c.dynamicClient.Resource(uninstall.WorkloadsGVR).List(ctx, metav1.ListOptions{})
+	if err != nil && !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+		return nil, nil, fmt.Errorf("failed to list Workloads: %w", err)
+	}
+	if err == nil {
+		for _, workload := range workloads.Items {
+			if uninstall.IsPending(workload) {
+				pendingWorkloads = append(pendingWorkloads, workload)
+			}
+		}
+	}
+
+	pods, err := c.kubeClient.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{LabelSelector: uninstall.ManagedPodsSelector()})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list pods managed by Kueue: %w", err)
+	}
+	gatedPods := []corev1.Pod{}
+	for _, pod := range pods.Items {
+		if uninstall.IsGated(&pod) {
+			gatedPods = append(gatedPods, pod)
+		}
+	}
+	return pendingWorkloads, gatedPods, nil
+}
+
+// holdUninstall reports whether the uninstallation is held by the Block uninstall
+// policy, because workloads still wait for admission. The pending workloads are
+// reported in the UninstallReady condition.
+func (c *TargetConfigReconciler) holdUninstall(ctx context.Context, kueue *kueuev1.Kueue) (bool, error) {
+	workloads, pods, err := c.pendingWorkloads(ctx)
+	if err != nil {
+		return false, err
+	}
+	if len(workloads) == 0 && len(pods) == 0 {
+		return false, nil
+	}
+
+	workloadNames := make([]string, 0, len(workloads))
+	for _, workload := range workloads {
+		workloadNames = append(workloadNames, workload.GetNamespace()+"/"+workload.GetName())
+	}
+	podNames := make([]string, 0, len(pods))
+	for _, pod := range pods {
+		podNames = append(podNames, pod.Namespace+"/"+pod.Name)
+	}
+	summary := uninstall.Summary(workloadNames, podNames)
+	klog.Infof("Uninstallation of Kueue is blocked by %s", summary)
+	condition := applyoperatorv1.OperatorCondition().
+		WithType(uninstallReadyConditionType).
+		WithStatus(operatorv1.ConditionFalse).
+		WithReason("WorkloadsPending").
+		WithMessage(fmt.Sprintf("Kueue is uninstalled once no workload waits for admission, with the Block uninstall policy: %s are pending", summary))
+	if err := applyStatusConditions(ctx, c.operatorClient, kueue, uninstallFieldManager, condition); err != nil {
+		return true, err
+	}
+	return true, nil
+}
+
+// releaseWorkloads stops Kueue, then unsuspends the jobs of the enabled integrations
+// and removes the admission gate from the pods that wait for admission. Kueue would
+// suspend the jobs again while running, so an error is returned until its pods are gone.
+func (c *TargetConfigReconciler) releaseWorkloads(ctx context.Context, kueue *kueuev1.Kueue) error {
+	if err := c.stopKueue(ctx); err != nil {
+		return err
+	}
+
+	workloads, pods, err := c.pendingWorkloads(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errorList []error
+	released := 0
+	for _, workload := range workloads {
+		owner, ok := integration.OwnerOf(workload, kueue.Spec.Config.Integrations.Frameworks)
+		if !ok {
+			continue
+		}
+		patch, err := integration.UnsuspendPatch(owner.API)
+		if err != nil {
+			return err
+		}
+		_, err = c.dynamicClient.Resource(owner.API.GroupVersionResource()).Namespace(owner.Namespace).Patch(ctx, owner.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			errorList = append(errorList, fmt.Errorf("failed to unsuspend %s: %w", owner, err))
+			continue
+		}
+		klog.V(2).Infof("Unsuspended %s", owner)
+		released++
+	}
+
+	ungated := 0
+	for _, pod := range pods {
+		_, err := c.kubeClient.CoreV1().Pods(pod.Namespace).Patch(ctx, pod.Name, types.StrategicMergePatchType, uninstall.RemoveGatePatch(), metav1.PatchOptions{})
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			errorList = append(errorList, fmt.Errorf("failed to remove the %s scheduling gate from pod %s/%s: %w", uninstall.AdmissionGate, pod.Namespace, pod.Name, err))
+			continue
+		}
+		klog.V(2).Infof("Removed the %s scheduling gate from pod %s/%s", uninstall.AdmissionGate, pod.Namespace, pod.Name)
+		ungated++
+	}
+
+	if released > 0 || ungated > 0 {
+		c.eventRecorder.Eventf("WorkloadsReleased", "Unsuspended %d jobs and ungated %d pods waiting for admission by Kueue", released, ungated)
+	}
+	return utilerror.NewAggregate(errorList)
+}
+
+// stopKueue deletes the Kueue deployment, and returns an error until its pods are gone.
+func (c *TargetConfigReconciler) stopKueue(ctx context.Context) error {
+	err := c.kubeClient.AppsV1().Deployments(c.operatorNamespace).Delete(ctx, operatorclient.OperandName, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete Deployment %s/%s: %w", c.operatorNamespace, operatorclient.OperandName, err)
+	}
+	kueuePods, err := c.kubeClient.CoreV1().Pods(c.operatorNamespace).List(ctx, metav1.ListOptions{LabelSelector: kueuePodSelector})
+	if err != nil {
+		return fmt.Errorf("failed to list Kueue pods: %w", err)
+	}
+	if len(kueuePods.Items) > 0 {
+		return fmt.Errorf("waiting for %d Kueue pods to terminate", len(kueuePods.Items))
+	}
+	return nil
+}
+
+// kueueCustomResourceDefinitions returns the Kueue CRDs installed by the operator.
+func kueueCustomResourceDefinitions() ([]*apiextensionsv1.CustomResourceDefinition, error) {
+	crdDir := "assets/kueue-operator/crds"
+	files, err := bindata.AssetDir(crdDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read crd directory: %w", err)
+	}
+	crds := []*apiextensionsv1.CustomResourceDefinition{}
+	for _, file := range files {
+		crd := resourceread.ReadCustomResourceDefinitionV1OrDie(bindata.MustAsset(filepath.Join(crdDir, file)))
+		if slices.ContainsFunc(crd.Spec.Versions, func(version apiextensionsv1.CustomResourceDefinitionVersion) bool {
+			return strings.HasPrefix(version.Name, "v1alpha")
+		}) {
+			continue
+		}
+		crds = append(crds, crd)
+	}
+	return crds, nil
+}
+
+// storageResource returns the resource of the CRD at its storage version.
+func storageResource(crd *apiextensionsv1.CustomResourceDefinition) schema.GroupVersionResource {
+	gvr := schema.GroupVersionResource{Group: crd.Spec.Group, Resource: crd.Spec.Names.Plural}
+	for _, version := range crd.Spec.Versions {
+		if version.Storage {
+			gvr.Version = version.Name
+		}
+	}
+	return gvr
+}
+
+// exportKueueResources exports the Kueue custom resources to ConfigMaps, once for the
+// Kueue CR. The ConfigMap listing the export is written last, so that an export
+// interrupted is taken again.
+func (c *TargetConfigReconciler) exportKueueResources(ctx context.Context, kueue *kueuev1.Kueue) error {
+	export := kueue.Spec.UninstallExport.ConfigMap
+	index, err := c.kubeClient.CoreV1().ConfigMaps(export.Namespace).Get(ctx, export.Name, metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get ConfigMap %s/%s: %w", export.Namespace, export.Name, err)
+	}
+	if err == nil && uninstall.IsExported(index, string(kueue.UID)) {
+		return nil
+	}
+
+	crds, err := kueueCustomResourceDefinitions()
+	if err != nil {
+		return err
+	}
+	objects := []unstructured.Unstructured{}
+	for _, crd := range crds {
+		gvr := storageResource(crd)
+		list, err := c.dynamicClient.Resource(gvr).List(ctx, metav1.ListOptions{})
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to list %s: %w", gvr.GroupResource(), err)
+		}
+		objects = append(objects, list.Items...)
+	}
+
+	configMaps, err := uninstall.ExportConfigMaps(export, string(kueue.UID), objects)
+	if err != nil {
+		return err
+	}
+	for _, configMap := range configMaps {
+		if _, _, err := resourceapply.ApplyConfigMap(ctx, c.kubeClient.CoreV1(), c.eventRecorder, configMap); err != nil {
+			return fmt.Errorf("failed to export the Kueue custom resources to ConfigMap %s/%s: %w", configMap.Namespace, configMap.Name, err)
+		}
+	}
+	klog.Infof("Exported %d Kueue custom resources to ConfigMap %s/%s", len(objects), export.Namespace, export.Name)
+	return nil
+}
+
+// deleteKueueCustomResources deletes the Kueue CRDs, and with them the Kueue custom
+// resources. Kueue is stopped first, and their finalizers are removed, as Kueue is not
+// running anymore to remove them.
+func (c *TargetConfigReconciler) deleteKueueCustomResources(ctx context.Context) error {
+	if err := c.stopKueue(ctx); err != nil {
+		return err
+	}
+	crds, err := kueueCustomResourceDefinitions()
+	if err != nil {
+		return err
+	}
+
+	var errorList []error
+	for _, crd := range crds {
+		gvr := storageResource(crd)
+		list, err := c.dynamicClient.Resource(gvr).List(ctx, metav1.ListOptions{})
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			continue
+		}
+		if err != nil {
+			errorList = append(errorList, fmt.Errorf("failed to list %s: %w", gvr.GroupResource(), err))
+			continue
+		}
+		for _, obj := range list.Items {
+			if len(obj.GetFinalizers()) == 0 {
+				continue
+			}
+			_, err := c.dynamicClient.Resource(gvr).Namespace(obj.GetNamespace()).Patch(ctx, obj.GetName(), types.MergePatchType, []byte(`{"metadata":{"finalizers":null}}`), metav1.PatchOptions{})
+			if err != nil && !apierrors.IsNotFound(err) {
+				errorList = append(errorList, fmt.Errorf("failed to remove the finalizers of %s %s/%s: %w", gvr.GroupResource(), obj.GetNamespace(), obj.GetName(), err))
+			}
+		}
+	}
+	if len(errorList) > 0 {
+		return utilerror.NewAggregate(errorList)
+	}
+
+	for _, crd := range crds {
+		err := c.crdClient.CustomResourceDefinitions().Delete(ctx, crd.Name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			errorList = append(errorList, fmt.Errorf("failed to delete CustomResourceDefinition %s: %w", crd.Name, err))
+			continue
+		}
+		resourcehelper.ReportDeleteEvent(c.eventRecorder, crd, err)
+	}
+	return utilerror.NewAggregate(errorList)
+}
+
+// UninstallPreflightController reports in the UninstallPreflight condition the admitted
+// and pending workloads, and what uninstalling Kueue would do with them.
+type UninstallPreflightController struct {
+	operatorClient kueueconfigclient.KueueV1Interface
+	kueueClient    *operatorclient.KueueClient
+	queueInformers dynamicinformer.DynamicSharedInformerFactory
+}
+
+func NewUninstallPreflightController(
+	operatorConfigClient kueueconfigclient.KueueV1Interface,
+	kueueClient *operatorclient.KueueClient,
+	queueInformers dynamicinformer.DynamicSharedInformerFactory,
+	eventRecorder events.Recorder,
+) factory.Controller {
+	c := &UninstallPreflightController{
+		operatorClient: operatorConfigClient,
+		kueueClient:    kueueClient,
+		queueInformers: queueInformers,
+	}
+
+	// As for the admitted workloads annotation, the cached LocalQueues are read on every
+	// resync rather than watched.
+	return factory.New().
+		WithInformers(kueueClient.Informer()).
+		ResyncEvery(time.Minute).
+		WithSync(c.sync).
+		ToController("UninstallPreflightController", eventRecorder)
+}
+
+func (c *UninstallPreflightController) sync(ctx context.Context, syncCtx factory.SyncContext) error {
+	obj, exists, err := c.kueueClient.Informer().GetIndexer().GetByKey(operatorclient.OperatorConfigName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+	kueue, ok := obj.(*kueuev1.Kueue)
+	if !ok {
+		klog.Errorf("unable to convert cached object to Kueue type")
+		return nil
+	}
+	if kueue.DeletionTimestamp != nil {
+		return nil
+	}
+
+	localQueues, synced, err := listCachedQueues(c.queueInformers, queues.LocalQueuesGVR, labels.Everything())
+	if err != nil {
+		return err
+	}
+	if !synced {
+		// The condition is reported once the LocalQueues are cached.
+		return nil
+	}
+	counts := uninstall.CountWorkloads(localQueues)
+
+	reason := "NoWorkloads"
+	if counts.Admitted > 0 || counts.Pending > 0 {
+		reason = "WorkloadsPresent"
+	}
+	condition := applyoperatorv1.OperatorCondition().
+		WithType(uninstallPreflightConditionType).
+		WithStatus(operatorv1.ConditionTrue).
+		WithReason(reason).
+		WithMessage(uninstall.PreflightMessage(kueue.Spec, counts))
+	return applyStatusConditions(ctx, c.operatorClient, kueue, uninstallPreflightFieldManager, condition)
+}
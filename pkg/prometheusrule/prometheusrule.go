@@ -0,0 +1,208 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package prometheusrule builds the PrometheusRule holding the alerts on the health of
+// Kueue and the recording rules of the utilization of its ClusterQueues.
+package prometheusrule
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	kueue "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// Name is the name of the PrometheusRule.
+	Name = "kueue"
+
+	alertsGroup         = "kueue.alerts"
+	recordingRulesGroup = "kueue.rules"
+)
+
+// alert describes an alert of the PrometheusRule. The expression is formatted with the
+// namespace of Kueue, then with the threshold when the alert has one. The threshold of
+// the alerts with thresholdIsDuration is the duration, in seconds, the expression holds
+// before the alert fires.
+type alert struct {
+	name                kueue.KueueAlert
+	expr                string
+	defaultThreshold    string
+	thresholdIsDuration bool
+	duration            monitoringv1.Duration
+	severity            string
+	summary             string
+	description         string
+}
+
+var alerts = []alert{
+	{
+		name:        kueue.KueueOperandUnavailable,
+		expr:        `kube_deployment_status_replicas_available{namespace="%[1]s",deployment="kueue-controller-manager"} == 0`,
+		duration:    "5m",
+		severity:    "critical",
+		summary:     "Kueue is unavailable.",
+		description: "No replica of the Kueue controller manager is available: workloads are neither admitted nor created.",
+	},
+	{
+		// The admission wait time is only observed once workloads are admitted, the
+		// workloads which are never admitted are reported by the pending gauge.
+		name:                kueue.KueueWorkloadsPendingTooLong,
+		expr:                `sum by (cluster_queue) (kueue_pending_workloads{namespace="%[1]s",status="inadmissible"}) > 0`,
+		defaultThreshold:    "3600",
+		thresholdIsDuration: true,
+		severity:            "warning",
+		summary:             "Workloads wait too long for admission.",
+		description:         "ClusterQueue {{ $labels.cluster_queue }} has had inadmissible pending workloads for too long, {{ $value }} workloads are inadmissible.",
+	},
+	{
+		name:             kueue.KueueAdmissionCycleSlow,
+		expr:             `histogram_quantile(0.99, sum by (le) (rate(kueue_admission_attempt_duration_seconds_bucket{namespace="%[1]s"}[10m]))) > %[2]s`,
+		defaultThreshold: "1",
+		duration:         "15m",
+		severity:         "warning",
+		summary:          "Kueue admission attempts are slow.",
+		description:      "The 99th percentile of the duration of the admission attempts of Kueue is {{ $value | humanizeDuration }}.",
+	},
+	{
+		name:             kueue.KueueWebhookErrors,
+		expr:             `100 * sum by (webhook) (rate(controller_runtime_webhook_requests_total{namespace="%[1]s",code=~"5.."}[5m])) / sum by (webhook) (rate(controller_runtime_webhook_requests_total{namespace="%[1]s"}[5m])) > %[2]s`,
+		defaultThreshold: "5",
+		duration:         "10m",
+		severity:         "warning",
+		summary:          "Kueue webhook requests fail.",
+		description:      "{{ $value | humanize }}% of the admission requests of the Kueue webhook {{ $labels.webhook }} fail.",
+	},
+	{
+		// The metrics of cert-manager carry the namespace of the certificates in
+		// exported_namespace when they are scraped from the namespace of cert-manager.
+		name:             kueue.KueueCertificateExpiring,
+		expr:             `min by (name) (certmanager_certificate_expiration_timestamp_seconds{exported_namespace="%[1]s"} or certmanager_certificate_expiration_timestamp_seconds{namespace="%[1]s"}) - time() < %[2]s`,
+		defaultThreshold: "604800",
+		duration:         "1h",
+		severity:         "warning",
+		summary:          "A Kueue certificate is about to expire.",
+		description:      "The certificate {{ $labels.name }} of Kueue expires in {{ $value | humanizeDuration }} and has not been renewed by cert-manager.",
+	},
+	{
+		name:        kueue.KueueClusterQueueInactive,
+		expr:        `kueue_cluster_queue_status{namespace="%[1]s",status="pending"} == 1`,
+		duration:    "15m",
+		severity:    "warning",
+		summary:     "A ClusterQueue is inactive.",
+		description: "ClusterQueue {{ $labels.cluster_queue }} does not admit workloads, check its status for the reason.",
+	},
+}
+
+var recordingRules = []struct {
+	record string
+	expr   string
+}{
+	{
+		record: "kueue:cluster_queue_resource_usage:ratio",
+		expr:   `sum by (cluster_queue, flavor, resource) (kueue_cluster_queue_resource_usage{namespace="%[1]s"}) / sum by (cluster_queue, flavor, resource) (kueue_cluster_queue_nominal_quota{namespace="%[1]s"} > 0)`,
+	},
+	{
+		record: "kueue:cluster_queue_pending_workloads:sum",
+		expr:   `sum by (cluster_queue) (kueue_pending_workloads{namespace="%[1]s"})`,
+	},
+}
+
+// Build returns the PrometheusRule of the Kueue deployed in the namespace, or nil when
+// all its rules are disabled.
+func Build(monitoring kueue.Monitoring, namespace string) *monitoringv1.PrometheusRule {
+	configurations := map[kueue.KueueAlert]kueue.AlertConfiguration{}
+	for _, a := range monitoring.Alerts {
+		configurations[a.Name] = a
+	}
+
+	var groups []monitoringv1.RuleGroup
+	var alertRules []monitoringv1.Rule
+	for _, a := range alerts {
+		configuration := configurations[a.name]
+		if configuration.State == kueue.RuleStateDisabled {
+			continue
+		}
+		threshold := a.defaultThreshold
+		if configuration.Threshold != nil {
+			threshold = strconv.FormatFloat(configuration.Threshold.AsApproximateFloat64(), 'f', -1, 64)
+		}
+		expr, duration := fmt.Sprintf(a.expr, namespace, threshold), a.duration
+		if a.thresholdIsDuration {
+			expr, duration = fmt.Sprintf(a.expr, namespace), secondsToDuration(threshold)
+		}
+		alertRules = append(alertRules, monitoringv1.Rule{
+			Alert: string(a.name),
+			Expr:  intstr.FromString(expr),
+			For:   ptr.To(duration),
+			Labels: map[string]string{
+				"severity": a.severity,
+			},
+			Annotations: map[string]string{
+				"summary":     a.summary,
+				"description": a.description,
+			},
+		})
+	}
+	if len(alertRules) > 0 {
+		groups = append(groups, monitoringv1.RuleGroup{Name: alertsGroup, Rules: alertRules})
+	}
+
+	if monitoring.RecordingRules != kueue.RuleStateDisabled {
+		var rules []monitoringv1.Rule
+		for _, r := range recordingRules {
+			rules = append(rules, monitoringv1.Rule{
+				Record: r.record,
+				Expr:   intstr.FromString(fmt.Sprintf(r.expr, namespace)),
+			})
+		}
+		groups = append(groups, monitoringv1.RuleGroup{Name: recordingRulesGroup, Rules: rules})
+	}
+
+	if len(groups) == 0 {
+		return nil
+	}
+	return &monitoringv1.PrometheusRule{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: monitoringv1.SchemeGroupVersion.String(),
+			Kind:       monitoringv1.PrometheusRuleKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      Name,
+			Namespace: namespace,
+		},
+		Spec: monitoringv1.PrometheusRuleSpec{Groups: groups},
+	}
+}
+
+// secondsToDuration formats a threshold in seconds as a Prometheus duration, which only
+// has integer units.
+func secondsToDuration(seconds string) monitoringv1.Duration {
+	value, err := strconv.ParseFloat(seconds, 64)
+	if err != nil {
+		return monitoringv1.Duration(seconds + "s")
+	}
+	milliseconds := int64(math.Round(value * 1000))
+	if milliseconds%1000 == 0 {
+		return monitoringv1.Duration(fmt.Sprintf("%ds", milliseconds/1000))
+	}
+	return monitoringv1.Duration(fmt.Sprintf("%dms", milliseconds))
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prometheusrule
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	kueue "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+)
+
+func TestBuild(t *testing.T) {
+	testCases := map[string]struct {
+		monitoring kueue.Monitoring
+		// want maps the name of each group to the name of its rules and their expression.
+		want map[string]map[string]string
+		// wantDurations maps the name of alerts to their duration, when checked.
+		wantDurations map[string]string
+	}{
+		"defaults": {
+			want: map[string]map[string]string{
+				alertsGroup: {
+					"KueueOperandUnavailable":      `kube_deployment_status_replicas_available{namespace="openshift-kueue-operator",deployment="kueue-controller-manager"} == 0`,
+					"KueueWorkloadsPendingTooLong": `sum by (cluster_queue) (kueue_pending_workloads{namespace="openshift-kueue-operator",status="inadmissible"}) > 0`,
+					"KueueAdmissionCycleSlow":      `histogram_quantile(0.99, sum by (le) (rate(kueue_admission_attempt_duration_seconds_bucket{namespace="openshift-kueue-operator"}[10m]))) > 1`,
+					"KueueWebhookErrors":           `100 * sum by (webhook) (rate(controller_runtime_webhook_requests_total{namespace="openshift-kueue-operator",code=~"5.."}[5m])) / sum by (webhook) (rate(controller_runtime_webhook_requests_total{namespace="openshift-kueue-operator"}[5m])) > 5`,
+					"KueueCertificateExpiring":     `min by (name) (certmanager_certificate_expiration_timestamp_seconds{exported_namespace="openshift-kueue-operator"} or certmanager_certificate_expiration_timestamp_seconds{namespace="openshift-kueue-operator"}) - time() < 604800`,
+					"KueueClusterQueueInactive":    `kueue_cluster_queue_status{namespace="openshift-kueue-operator",status="pending"} == 1`,
+				},
+				recordingRulesGroup: {
+					"kueue:cluster_queue_resource_usage:ratio":  `sum by (cluster_queue, flavor, resource) (kueue_cluster_queue_resource_usage{namespace="openshift-kueue-operator"}) / sum by (cluster_queue, flavor, resource) (kueue_cluster_queue_nominal_quota{namespace="openshift-kueue-operator"} > 0)`,
+					"kueue:cluster_queue_pending_workloads:sum": `sum by (cluster_queue) (kueue_pending_workloads{namespace="openshift-kueue-operator"})`,
+				},
+			},
+			wantDurations: map[string]string{
+				"KueueOperandUnavailable":      "5m",
+				"KueueWorkloadsPendingTooLong": "3600s",
+				"KueueAdmissionCycleSlow":      "15m",
+			},
+		},
+		"pending threshold is the alert duration": {
+			monitoring: kueue.Monitoring{
+				Alerts: []kueue.AlertConfiguration{
+					{Name: kueue.KueueOperandUnavailable, State: kueue.RuleStateDisabled},
+					{Name: kueue.KueueWorkloadsPendingTooLong, Threshold: ptr.To(resource.MustParse("90500m"))},
+					{Name: kueue.KueueAdmissionCycleSlow, State: kueue.RuleStateDisabled},
+					{Name: kueue.KueueWebhookErrors, State: kueue.RuleStateDisabled},
+					{Name: kueue.KueueCertificateExpiring, State: kueue.RuleStateDisabled},
+					{Name: kueue.KueueClusterQueueInactive, State: kueue.RuleStateDisabled},
+				},
+				RecordingRules: kueue.RuleStateDisabled,
+			},
+			want: map[string]map[string]string{
+				alertsGroup: {
+					"KueueWorkloadsPendingTooLong": `sum by (cluster_queue) (kueue_pending_workloads{namespace="openshift-kueue-operator",status="inadmissible"}) > 0`,
+				},
+			},
+			wantDurations: map[string]string{
+				"KueueWorkloadsPendingTooLong": "90500ms",
+			},
+		},
+		"disabled alerts and thresholds": {
+			monitoring: kueue.Monitoring{
+				Alerts: []kueue.AlertConfiguration{
+					{Name: kueue.KueueOperandUnavailable, State: kueue.RuleStateDisabled},
+					{Name: kueue.KueueWorkloadsPendingTooLong, State: kueue.RuleStateDisabled},
+					{Name: kueue.KueueWebhookErrors, State: kueue.RuleStateDisabled},
+					{Name: kueue.KueueCertificateExpiring, State: kueue.RuleStateDisabled},
+					{Name: kueue.KueueClusterQueueInactive, State: kueue.RuleStateDisabled},
+					{Name: kueue.KueueAdmissionCycleSlow, Threshold: ptr.To(resource.MustParse("500m"))},
+				},
+				RecordingRules: kueue.RuleStateDisabled,
+			},
+			want: map[string]map[string]string{
+				alertsGroup: {
+					"KueueAdmissionCycleSlow": `histogram_quantile(0.99, sum by (le) (rate(kueue_admission_attempt_duration_seconds_bucket{namespace="openshift-kueue-operator"}[10m]))) > 0.5`,
+				},
+			},
+		},
+		"only recording rules": {
+			monitoring: kueue.Monitoring{
+				Alerts: []kueue.AlertConfiguration{
+					{Name: kueue.KueueOperandUnavailable, State: kueue.RuleStateDisabled},
+					{Name: kueue.KueueWorkloadsPendingTooLong, State: kueue.RuleStateDisabled},
+					{Name: kueue.KueueAdmissionCycleSlow, State: kueue.RuleStateDisabled},
+					{Name: kueue.KueueWebhookErrors, State: kueue.RuleStateDisabled},
+					{Name: kueue.KueueCertificateExpiring, State: kueue.RuleStateDisabled},
+					{Name: kueue.KueueClusterQueueInactive, State: kueue.RuleStateDisabled},
+				},
+				RecordingRules: kueue.RuleStateEnabled,
+			},
+			want: map[string]map[string]string{
+				recordingRulesGroup: {
+					"kueue:cluster_queue_resource_usage:ratio":  `sum by (cluster_queue, flavor, resource) (kueue_cluster_queue_resource_usage{namespace="openshift-kueue-operator"}) / sum by (cluster_queue, flavor, resource) (kueue_cluster_queue_nominal_quota{namespace="openshift-kueue-operator"} > 0)`,
+					"kueue:cluster_queue_pending_workloads:sum": `sum by (cluster_queue) (kueue_pending_workloads{namespace="openshift-kueue-operator"})`,
+				},
+			},
+		},
+		"all disabled": {
+			monitoring: kueue.Monitoring{
+				Alerts: []kueue.AlertConfiguration{
+					{Name: kueue.KueueOperandUnavailable, State: kueue.RuleStateDisabled},
+					{Name: kueue.KueueWorkloadsPendingTooLong, State: kueue.RuleStateDisabled},
+					{Name: kueue.KueueAdmissionCycleSlow, State: kueue.RuleStateDisabled},
+					{Name: kueue.KueueWebhookErrors, State: kueue.RuleStateDisabled},
+					{Name: kueue.KueueCertificateExpiring, State: kueue.RuleStateDisabled},
+					{Name: kueue.KueueClusterQueueInactive, State: kueue.RuleStateDisabled},
+				},
+				RecordingRules: kueue.RuleStateDisabled,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rule := Build(tc.monitoring, "openshift-kueue-operator")
+			if tc.want == nil {
+				if rule != nil {
+					t.Fatalf("Build() = %v, want nil", rule)
+				}
+				return
+			}
+			if rule == nil {
+				t.Fatal("Build() = nil")
+			}
+			got := map[string]map[string]string{}
+			gotDurations := map[string]string{}
+			for _, group := range rule.Spec.Groups {
+				got[group.Name] = map[string]string{}
+				for _, r := range group.Rules {
+					got[group.Name][r.Alert+r.Record] = r.Expr.String()
+					if _, ok := tc.wantDurations[r.Alert]; ok && r.For != nil {
+						gotDurations[r.Alert] = string(*r.For)
+					}
+				}
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Unexpected rules (-want,+got):\n%s", diff)
+			}
+			if tc.wantDurations != nil {
+				if diff := cmp.Diff(tc.wantDurations, gotDurations); diff != "" {
+					t.Errorf("Unexpected durations (-want,+got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queueassignment
+
+import (
+	"fmt"
+	"strings"
+
+	kueue "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+)
+
+// GuardrailsPolicyName is the name of the ValidatingAdmissionPolicy enforcing the
+// tenant guardrails, and of its binding.
+const GuardrailsPolicyName = "kueue-tenant-guardrails"
+
+// guardedLabel is a label restricted by the tenant guardrails.
+type guardedLabel struct {
+	// variable is the prefix of the CEL variables of the label.
+	variable string
+	key      string
+	// kind names the objects the label refers to in the messages.
+	kind    string
+	allowed func(kueue.TenantGuardrailRule) []string
+}
+
+var guardedLabels = []guardedLabel{
+	{
+		variable: "queueName",
+		key:      QueueNameLabel,
+		kind:     "LocalQueue",
+		allowed:  func(rule kueue.TenantGuardrailRule) []string { return rule.AllowedQueueNames },
+	},
+	{
+		variable: "priorityClass",
+		key:      PriorityClassLabel,
+		kind:     "WorkloadPriorityClass",
+		allowed:  func(rule kueue.TenantGuardrailRule) []string { return rule.AllowedPriorityClasses },
+	},
+}
+
+// celLabelValue returns a CEL expression evaluating to the value of the label of obj, or
+// to an empty string when obj does not have the label.
+func celLabelValue(obj, key string) string {
+	return fmt.Sprintf("has(%[1]s.metadata.labels) && %[2]s in %[1]s.metadata.labels ? %[1]s.metadata.labels[%[2]s] : \"\"", obj, celString(key))
+}
+
+// BuildValidatingAdmissionPolicy returns the ValidatingAdmissionPolicy enforcing the
+// tenant guardrails on the given resources, and its binding.
+//
+// Workloads may use the values allowed by any of the rules that match them, when at
+// least one matching rule restricts the label. Updates keeping the value of a label are
+// always allowed, so that tightening the guardrails does not block existing workloads.
+func BuildValidatingAdmissionPolicy(rules []kueue.TenantGuardrailRule, resources []schema.GroupResource, operatorNamespace string) (*admissionregistrationv1.ValidatingAdmissionPolicy, *admissionregistrationv1.ValidatingAdmissionPolicyBinding) {
+	resourceRules := []admissionregistrationv1.NamedRuleWithOperations{}
+	for _, rule := range Rules(resources) {
+		rule.Operations = append(rule.Operations, admissionregistrationv1.Update)
+		resourceRules = append(resourceRules, admissionregistrationv1.NamedRuleWithOperations{RuleWithOperations: rule})
+	}
+
+	variables := []admissionregistrationv1.Variable{}
+	validations := []admissionregistrationv1.Validation{}
+	for _, label := range guardedLabels {
+		allowed, restricted := []string{}, []string{}
+		for _, rule := range rules {
+			values := label.allowed(rule)
+			if len(values) == 0 {
+				continue
+			}
+			condition := celSelects(rule.NamespaceLabels, nil, rule.Groups)
+			allowed = append(allowed, fmt.Sprintf("(%s ? %s : [])", condition, celList(values)))
+			restricted = append(restricted, fmt.Sprintf("(%s)", condition))
+		}
+		if len(allowed) == 0 {
+			continue
+		}
+
+		value := "variables." + label.variable
+		allowedValues := fmt.Sprintf("variables.%sAllowed", label.variable)
+		variables = append(variables,
+			admissionregistrationv1.Variable{Name: label.variable, Expression: celLabelValue("object", label.key)},
+			admissionregistrationv1.Variable{Name: label.variable + "Old", Expression: fmt.Sprintf("oldObject == null ? \"\" : (%s)", celLabelValue("oldObject", label.key))},
+			admissionregistrationv1.Variable{Name: label.variable + "Allowed", Expression: strings.Join(allowed, " + ")},
+			admissionregistrationv1.Variable{Name: label.variable + "Restricted", Expression: strings.Join(restricted, " || ")},
+		)
+		validations = append(validations, admissionregistrationv1.Validation{
+			Expression: fmt.Sprintf("!variables.%[1]sRestricted || %[2]s == \"\" || %[2]s == variables.%[1]sOld || %[2]s in %[3]s", label.variable, value, allowedValues),
+			MessageExpression: fmt.Sprintf("%s + %s + %s + request.namespace + %s + %s.join(\", \")",
+				celString(label.kind+" "), value, celString(" is not allowed in namespace "), celString(", the allowed values are: "), allowedValues),
+			Reason: ptr.To(metav1.StatusReasonForbidden),
+		})
+	}
+
+	policy := &admissionregistrationv1.ValidatingAdmissionPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: GuardrailsPolicyName},
+		Spec: admissionregistrationv1.ValidatingAdmissionPolicySpec{
+			MatchConstraints: &admissionregistrationv1.MatchResources{
+				NamespaceSelector: ExcludedNamespacesSelector(operatorNamespace),
+				ObjectSelector:    &metav1.LabelSelector{},
+				ResourceRules:     resourceRules,
+				MatchPolicy:       ptr.To(admissionregistrationv1.Equivalent),
+			},
+			MatchConditions: []admissionregistrationv1.MatchCondition{
+				{Name: "top-level-workload", Expression: topLevelCondition},
+			},
+			Variables:     variables,
+			Validations:   validations,
+			FailurePolicy: ptr.To(admissionregistrationv1.Fail),
+		},
+	}
+	binding := &admissionregistrationv1.ValidatingAdmissionPolicyBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: GuardrailsPolicyName},
+		Spec: admissionregistrationv1.ValidatingAdmissionPolicyBindingSpec{
+			PolicyName:        GuardrailsPolicyName,
+			ValidationActions: []admissionregistrationv1.ValidationAction{admissionregistrationv1.Deny},
+		},
+	}
+	return policy, binding
+}
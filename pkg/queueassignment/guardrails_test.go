@@ -0,0 +1,182 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queueassignment
+
+import (
+	"testing"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/ext"
+
+	kueue "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+)
+
+func testGuardrails() []kueue.TenantGuardrailRule {
+	return []kueue.TenantGuardrailRule{
+		{
+			Name:                   "research",
+			NamespaceLabels:        map[string]string{"team": "research"},
+			AllowedQueueNames:      []string{"research"},
+			AllowedPriorityClasses: []string{"low", "normal"},
+		},
+		{
+			Name:                   "ml-admins",
+			Groups:                 []string{"ml-admins"},
+			AllowedPriorityClasses: []string{"critical"},
+		},
+	}
+}
+
+// evalGuardrails evaluates the ValidatingAdmissionPolicy of the guardrails the way the
+// API server does, and returns the messages of the failed validations.
+func evalGuardrails(t *testing.T, rules []kueue.TenantGuardrailRule, namespaceLabels map[string]string, groups []string, labels, oldLabels map[string]string) []string {
+	t.Helper()
+	policy, _ := BuildValidatingAdmissionPolicy(rules, nil, "openshift-kueue-operator")
+	env, err := cel.NewEnv(
+		ext.Strings(),
+		cel.Variable("object", cel.DynType),
+		cel.Variable("oldObject", cel.DynType),
+		cel.Variable("namespaceObject", cel.DynType),
+		cel.Variable("request", cel.DynType),
+		cel.Variable("variables", cel.MapType(cel.StringType, cel.DynType)),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	eval := func(expression string, activation map[string]interface{}) interface{} {
+		ast, issues := env.Compile(expression)
+		if issues.Err() != nil {
+			t.Fatalf("%s does not compile: %v", expression, issues.Err())
+		}
+		program, err := env.Program(ast)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		value, _, err := program.Eval(activation)
+		if err != nil {
+			t.Fatalf("%s failed: %v", expression, err)
+		}
+		return value.Value()
+	}
+
+	toMap := func(labels map[string]string) map[string]interface{} {
+		metadata := map[string]interface{}{}
+		if labels != nil {
+			values := map[string]interface{}{}
+			for key, value := range labels {
+				values[key] = value
+			}
+			metadata["labels"] = values
+		}
+		return map[string]interface{}{"metadata": metadata}
+	}
+	userGroups := []interface{}{}
+	for _, group := range groups {
+		userGroups = append(userGroups, group)
+	}
+	var oldObject interface{}
+	if oldLabels != nil {
+		oldObject = toMap(oldLabels)
+	}
+	variables := map[string]interface{}{}
+	activation := map[string]interface{}{
+		"object":          toMap(labels),
+		"oldObject":       oldObject,
+		"namespaceObject": toMap(namespaceLabels),
+		"request":         map[string]interface{}{"namespace": "team", "userInfo": map[string]interface{}{"groups": userGroups}},
+		"variables":       variables,
+	}
+	for _, variable := range policy.Spec.Variables {
+		variables[variable.Name] = eval(variable.Expression, activation)
+	}
+	messages := []string{}
+	for _, validation := range policy.Spec.Validations {
+		if !eval(validation.Expression, activation).(bool) {
+			messages = append(messages, eval(validation.MessageExpression, activation).(string))
+		}
+	}
+	return messages
+}
+
+func TestBuildValidatingAdmissionPolicy(t *testing.T) {
+	testCases := map[string]struct {
+		namespaceLabels map[string]string
+		groups          []string
+		labels          map[string]string
+		oldLabels       map[string]string
+		want            []string
+	}{
+		"allowed queue and priority class": {
+			namespaceLabels: map[string]string{"team": "research"},
+			labels:          map[string]string{QueueNameLabel: "research", PriorityClassLabel: "normal"},
+		},
+		"workload without labels": {
+			namespaceLabels: map[string]string{"team": "research"},
+		},
+		"forbidden queue and priority class": {
+			namespaceLabels: map[string]string{"team": "research"},
+			labels:          map[string]string{QueueNameLabel: "production", PriorityClassLabel: "critical"},
+			want: []string{
+				"LocalQueue production is not allowed in namespace team, the allowed values are: research",
+				"WorkloadPriorityClass critical is not allowed in namespace team, the allowed values are: low, normal",
+			},
+		},
+		"values allowed by any matching rule": {
+			namespaceLabels: map[string]string{"team": "research"},
+			groups:          []string{"ml-admins"},
+			labels:          map[string]string{PriorityClassLabel: "critical"},
+		},
+		"unrestricted when no rule matches": {
+			namespaceLabels: map[string]string{"team": "finance"},
+			labels:          map[string]string{QueueNameLabel: "production", PriorityClassLabel: "critical"},
+		},
+		"updates keeping the labels are allowed": {
+			namespaceLabels: map[string]string{"team": "research"},
+			labels:          map[string]string{QueueNameLabel: "production", "app": "job"},
+			oldLabels:       map[string]string{QueueNameLabel: "production"},
+		},
+		"updates changing the labels are validated": {
+			namespaceLabels: map[string]string{"team": "research"},
+			labels:          map[string]string{QueueNameLabel: "production"},
+			oldLabels:       map[string]string{QueueNameLabel: "research"},
+			want:            []string{"LocalQueue production is not allowed in namespace team, the allowed values are: research"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := evalGuardrails(t, testGuardrails(), tc.namespaceLabels, tc.groups, tc.labels, tc.oldLabels)
+			if len(got) != len(tc.want) {
+				t.Fatalf("unexpected messages: %q, want %q", got, tc.want)
+			}
+			for i := range got {
+				if got[i] != tc.want[i] {
+					t.Errorf("unexpected message: %q, want %q", got[i], tc.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestBuildValidatingAdmissionPolicyWithoutRestrictions(t *testing.T) {
+	policy, _ := BuildValidatingAdmissionPolicy([]kueue.TenantGuardrailRule{
+		{Name: "queues", AllowedQueueNames: []string{"default"}},
+	}, nil, "openshift-kueue-operator")
+	if len(policy.Spec.Validations) != 1 {
+		t.Errorf("expected only the LocalQueues to be validated, got %d validations", len(policy.Spec.Validations))
+	}
+}
@@ -0,0 +1,318 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package queueassignment sets the queue and priority class labels of workloads
+// according to the queue assignment policy of the Kueue operator API, either through
+// a MutatingAdmissionPolicy or through a webhook served by the operator, and restricts
+// the values of these labels with the tenant guardrails.
+package queueassignment
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	kueue "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+	"github.com/openshift/kueue-operator/pkg/integration"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// QueueNameLabel is the label Kueue reads the LocalQueue of a workload from.
+	QueueNameLabel = "kueue.x-k8s.io/queue-name"
+	// PriorityClassLabel is the label Kueue reads the WorkloadPriorityClass of a workload from.
+	PriorityClassLabel = "kueue.x-k8s.io/priority-class"
+
+	// PolicyName is the name of the MutatingAdmissionPolicy and of its binding.
+	PolicyName = "kueue-queue-assignment"
+	// WebhookName is the name of the MutatingWebhookConfiguration used when
+	// MutatingAdmissionPolicies are not available.
+	WebhookName = "kueue-operator-queue-assignment"
+	// WebhookPath is the path the webhook is served on.
+	WebhookPath = "/mutate-queue-assignment"
+	// WebhookServiceName is the Service in front of the webhook server of the operator.
+	WebhookServiceName = "kueue-operator-webhook-service"
+)
+
+// Request describes a workload being created.
+type Request struct {
+	NamespaceLabels map[string]string
+	Groups          []string
+	Labels          map[string]string
+}
+
+// matches reports whether the rule applies to the request.
+func matches(rule kueue.QueueAssignmentRule, req Request) bool {
+	for key, value := range rule.NamespaceLabels {
+		if v, ok := req.NamespaceLabels[key]; !ok || v != value {
+			return false
+		}
+	}
+	for key, value := range rule.WorkloadLabels {
+		if v, ok := req.Labels[key]; !ok || v != value {
+			return false
+		}
+	}
+	if len(rule.Groups) > 0 && !slices.ContainsFunc(req.Groups, func(group string) bool {
+		return slices.Contains(rule.Groups, group)
+	}) {
+		return false
+	}
+	return true
+}
+
+// ruleValue returns the value a rule sets for a label, or an empty string if it does not
+// set the label.
+func ruleValue(rule kueue.QueueAssignmentRule, label string) string {
+	if label == QueueNameLabel {
+		return rule.QueueName
+	}
+	return rule.PriorityClass
+}
+
+// Assign returns the labels to set on the workload. For each label, the first rule that
+// matches the request and may set the label is used. Labels whose value is unchanged
+// are not returned.
+func Assign(rules []kueue.QueueAssignmentRule, req Request) map[string]string {
+	assigned := map[string]string{}
+	for _, label := range []string{QueueNameLabel, PriorityClassLabel} {
+		current, present := req.Labels[label]
+		for _, rule := range rules {
+			value := ruleValue(rule, label)
+			if value == "" || !matches(rule, req) {
+				continue
+			}
+			if present && rule.Mode != kueue.QueueAssignmentModeOverwrite {
+				continue
+			}
+			if value != current || !present {
+				assigned[label] = value
+			}
+			break
+		}
+	}
+	return assigned
+}
+
+// Resources returns the resources of the enabled integrations and external frameworks,
+// sorted by group and resource.
+func Resources(cfg kueue.KueueConfiguration) []schema.GroupResource {
+	resources := []schema.GroupResource{}
+	for _, framework := range cfg.Integrations.Frameworks {
+		if api, ok := integration.APIFor(framework); ok {
+			resources = append(resources, schema.GroupResource{Group: api.Group, Resource: api.Resource})
+		}
+	}
+	for _, framework := range cfg.Integrations.ExternalFrameworks {
+		resources = append(resources, schema.GroupResource{Group: framework.Group, Resource: framework.Resource})
+	}
+	slices.SortFunc(resources, func(a, b schema.GroupResource) int {
+		return strings.Compare(a.String(), b.String())
+	})
+	return slices.Compact(resources)
+}
+
+// Rules returns the admission rules matching the creation of the given resources in
+// any version.
+func Rules(resources []schema.GroupResource) []admissionregistrationv1.RuleWithOperations {
+	byGroup := map[string][]string{}
+	for _, resource := range resources {
+		byGroup[resource.Group] = append(byGroup[resource.Group], resource.Resource)
+	}
+	rules := []admissionregistrationv1.RuleWithOperations{}
+	for _, group := range slices.Sorted(maps.Keys(byGroup)) {
+		rules = append(rules, admissionregistrationv1.RuleWithOperations{
+			Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Create},
+			Rule: admissionregistrationv1.Rule{
+				APIGroups:   []string{group},
+				APIVersions: []string{"*"},
+				Resources:   byGroup[group],
+				Scope:       ptr.To(admissionregistrationv1.NamespacedScope),
+			},
+		})
+	}
+	return rules
+}
+
+// ExcludedNamespacesSelector selects the namespaces whose workloads are never labeled.
+func ExcludedNamespacesSelector(operatorNamespace string) *metav1.LabelSelector {
+	return &metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{
+			{
+				Key:      "kubernetes.io/metadata.name",
+				Operator: metav1.LabelSelectorOpNotIn,
+				Values:   []string{operatorNamespace, "kube-system"},
+			},
+		},
+	}
+}
+
+// topLevelCondition excludes the workloads created by other workloads, e.g. the Pods of a
+// Job, which Kueue manages through their owner.
+const topLevelCondition = "!has(object.metadata.ownerReferences) || !object.metadata.ownerReferences.exists(o, has(o.controller) && o.controller)"
+
+// celString returns s as a CEL string literal.
+func celString(s string) string {
+	return strconv.Quote(s)
+}
+
+// celLabelEquals returns a CEL expression checking that labels has the given label.
+func celLabelEquals(labels, key, value string) string {
+	return fmt.Sprintf("(has(%[1]s.metadata.labels) && %[2]s in %[1]s.metadata.labels && %[1]s.metadata.labels[%[2]s] == %[3]s)", labels, celString(key), celString(value))
+}
+
+// celMatches returns a CEL expression equivalent to matches.
+func celMatches(rule kueue.QueueAssignmentRule) string {
+	return celSelects(rule.NamespaceLabels, rule.WorkloadLabels, rule.Groups)
+}
+
+// celSelects returns a CEL expression checking that the namespace and the workload have
+// the given labels, and that the requesting user belongs to one of the groups.
+func celSelects(namespaceLabels, workloadLabels map[string]string, groups []string) string {
+	terms := []string{}
+	for _, key := range slices.Sorted(maps.Keys(namespaceLabels)) {
+		terms = append(terms, celLabelEquals("namespaceObject", key, namespaceLabels[key]))
+	}
+	for _, key := range slices.Sorted(maps.Keys(workloadLabels)) {
+		terms = append(terms, celLabelEquals("object", key, workloadLabels[key]))
+	}
+	if len(groups) > 0 {
+		terms = append(terms, fmt.Sprintf("has(request.userInfo.groups) && request.userInfo.groups.exists(g, g in %s)", celList(groups)))
+	}
+	if len(terms) == 0 {
+		return "true"
+	}
+	return strings.Join(terms, " && ")
+}
+
+// celList returns values as a CEL list literal.
+func celList(values []string) string {
+	literals := make([]string, 0, len(values))
+	for _, value := range values {
+		literals = append(literals, celString(value))
+	}
+	return "[" + strings.Join(literals, ", ") + "]"
+}
+
+// celValue returns a CEL expression evaluating to the value Assign sets for the label, or
+// to an empty string when the label is left unchanged.
+func celValue(rules []kueue.QueueAssignmentRule, label string) string {
+	present := fmt.Sprintf("(has(object.metadata.labels) && %s in object.metadata.labels)", celString(label))
+	expression := `""`
+	for _, rule := range slices.Backward(rules) {
+		value := ruleValue(rule, label)
+		if value == "" {
+			continue
+		}
+		condition := celMatches(rule)
+		if rule.Mode != kueue.QueueAssignmentModeOverwrite {
+			condition = fmt.Sprintf("!%s && %s", present, condition)
+		}
+		expression = fmt.Sprintf("(%s) ? %s : %s", condition, celString(value), expression)
+	}
+	return expression
+}
+
+// BuildMutatingAdmissionPolicy returns the MutatingAdmissionPolicy enforcing the rules on
+// the given resources, and its binding.
+func BuildMutatingAdmissionPolicy(rules []kueue.QueueAssignmentRule, resources []schema.GroupResource, operatorNamespace string) (*admissionregistrationv1beta1.MutatingAdmissionPolicy, *admissionregistrationv1beta1.MutatingAdmissionPolicyBinding) {
+	resourceRules := []admissionregistrationv1beta1.NamedRuleWithOperations{}
+	for _, rule := range Rules(resources) {
+		resourceRules = append(resourceRules, admissionregistrationv1beta1.NamedRuleWithOperations{RuleWithOperations: rule})
+	}
+
+	variables := []admissionregistrationv1beta1.Variable{}
+	mutations := []admissionregistrationv1beta1.Mutation{}
+	for _, label := range []struct{ name, key string }{
+		{name: "queueName", key: QueueNameLabel},
+		{name: "priorityClass", key: PriorityClassLabel},
+	} {
+		variables = append(variables, admissionregistrationv1beta1.Variable{
+			Name:       label.name,
+			Expression: celValue(rules, label.key),
+		})
+		mutations = append(mutations, admissionregistrationv1beta1.Mutation{
+			PatchType: admissionregistrationv1beta1.PatchTypeApplyConfiguration,
+			ApplyConfiguration: &admissionregistrationv1beta1.ApplyConfiguration{
+				Expression: fmt.Sprintf(`variables.%[1]s == "" ? Object{} : Object{metadata: Object.metadata{labels: {%[2]s: variables.%[1]s}}}`, label.name, celString(label.key)),
+			},
+		})
+	}
+
+	policy := &admissionregistrationv1beta1.MutatingAdmissionPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: PolicyName},
+		Spec: admissionregistrationv1beta1.MutatingAdmissionPolicySpec{
+			MatchConstraints: &admissionregistrationv1beta1.MatchResources{
+				NamespaceSelector: ExcludedNamespacesSelector(operatorNamespace),
+				ObjectSelector:    &metav1.LabelSelector{},
+				ResourceRules:     resourceRules,
+				MatchPolicy:       ptr.To(admissionregistrationv1beta1.Equivalent),
+			},
+			MatchConditions: []admissionregistrationv1beta1.MatchCondition{
+				{Name: "top-level-workload", Expression: topLevelCondition},
+			},
+			Variables: variables,
+			Mutations: mutations,
+			// Workloads are still created when the policy fails, they are then
+			// handled as if no rule matched.
+			FailurePolicy:      ptr.To(admissionregistrationv1beta1.Ignore),
+			ReinvocationPolicy: admissionregistrationv1beta1.NeverReinvocationPolicy,
+		},
+	}
+	binding := &admissionregistrationv1beta1.MutatingAdmissionPolicyBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: PolicyName},
+		Spec: admissionregistrationv1beta1.MutatingAdmissionPolicyBindingSpec{
+			PolicyName: PolicyName,
+		},
+	}
+	return policy, binding
+}
+
+// BuildMutatingWebhookConfiguration returns the MutatingWebhookConfiguration sending the
+// creation of the given resources to the webhook server of the operator.
+func BuildMutatingWebhookConfiguration(resources []schema.GroupResource, operatorNamespace string) *admissionregistrationv1.MutatingWebhookConfiguration {
+	return &admissionregistrationv1.MutatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: WebhookName},
+		Webhooks: []admissionregistrationv1.MutatingWebhook{
+			{
+				Name:                    "queueassignment.kueue.openshift.io",
+				AdmissionReviewVersions: []string{"v1"},
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					Service: &admissionregistrationv1.ServiceReference{
+						Name:      WebhookServiceName,
+						Namespace: operatorNamespace,
+						Path:      ptr.To(WebhookPath),
+					},
+				},
+				Rules:             Rules(resources),
+				NamespaceSelector: ExcludedNamespacesSelector(operatorNamespace),
+				MatchConditions: []admissionregistrationv1.MatchCondition{
+					{Name: "top-level-workload", Expression: topLevelCondition},
+				},
+				FailurePolicy:      ptr.To(admissionregistrationv1.Ignore),
+				SideEffects:        ptr.To(admissionregistrationv1.SideEffectClassNone),
+				ReinvocationPolicy: ptr.To(admissionregistrationv1.NeverReinvocationPolicy),
+				TimeoutSeconds:     ptr.To[int32](5),
+			},
+		},
+	}
+}
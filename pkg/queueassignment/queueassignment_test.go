@@ -0,0 +1,291 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queueassignment
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/go-cmp/cmp"
+	admissionv1 "k8s.io/api/admission/v1"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	kueue "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+)
+
+func testRules() []kueue.QueueAssignmentRule {
+	return []kueue.QueueAssignmentRule{
+		{
+			Name:          "ml-admins",
+			Groups:        []string{"ml-admins"},
+			PriorityClass: "high",
+			Mode:          kueue.QueueAssignmentModeOverwrite,
+		},
+		{
+			Name:            "research",
+			NamespaceLabels: map[string]string{"team": "research"},
+			QueueName:       "research",
+		},
+		{
+			Name:           "batch",
+			WorkloadLabels: map[string]string{"app.kubernetes.io/component": "batch"},
+			QueueName:      "batch",
+			PriorityClass:  "low",
+		},
+	}
+}
+
+var assignTestCases = map[string]struct {
+	req  Request
+	want map[string]string
+}{
+	"no rule matches": {
+		req:  Request{NamespaceLabels: map[string]string{"team": "finance"}},
+		want: map[string]string{},
+	},
+	"namespace labels": {
+		req:  Request{NamespaceLabels: map[string]string{"team": "research"}},
+		want: map[string]string{QueueNameLabel: "research"},
+	},
+	"first matching rule wins": {
+		req: Request{
+			NamespaceLabels: map[string]string{"team": "research"},
+			Labels:          map[string]string{"app.kubernetes.io/component": "batch"},
+		},
+		want: map[string]string{QueueNameLabel: "research", PriorityClassLabel: "low"},
+	},
+	"groups": {
+		req:  Request{Groups: []string{"system:authenticated", "ml-admins"}},
+		want: map[string]string{PriorityClassLabel: "high"},
+	},
+	"SetIfAbsent keeps the labels set by the user": {
+		req: Request{
+			NamespaceLabels: map[string]string{"team": "research"},
+			Labels:          map[string]string{QueueNameLabel: "mine"},
+		},
+		want: map[string]string{},
+	},
+	"Overwrite replaces the labels set by the user": {
+		req: Request{
+			Groups: []string{"ml-admins"},
+			Labels: map[string]string{PriorityClassLabel: "low"},
+		},
+		want: map[string]string{PriorityClassLabel: "high"},
+	},
+	"unchanged labels are not returned": {
+		req: Request{
+			Groups: []string{"ml-admins"},
+			Labels: map[string]string{PriorityClassLabel: "high"},
+		},
+		want: map[string]string{},
+	},
+}
+
+func TestAssign(t *testing.T) {
+	for name, tc := range assignTestCases {
+		t.Run(name, func(t *testing.T) {
+			got := Assign(testRules(), tc.req)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("unexpected labels (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestMutatingAdmissionPolicyVariables checks that the variables of the
+// MutatingAdmissionPolicy evaluate to the labels returned by Assign.
+func TestMutatingAdmissionPolicyVariables(t *testing.T) {
+	policy, _ := BuildMutatingAdmissionPolicy(testRules(), nil, "openshift-kueue-operator")
+	env, err := cel.NewEnv(
+		cel.Variable("object", cel.DynType),
+		cel.Variable("namespaceObject", cel.DynType),
+		cel.Variable("request", cel.DynType),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	programs := map[string]cel.Program{}
+	for _, variable := range policy.Spec.Variables {
+		ast, issues := env.Compile(variable.Expression)
+		if issues.Err() != nil {
+			t.Fatalf("variable %s does not compile: %v", variable.Name, issues.Err())
+		}
+		program, err := env.Program(ast)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		programs[variable.Name] = program
+	}
+	for _, condition := range policy.Spec.MatchConditions {
+		if _, issues := env.Compile(condition.Expression); issues.Err() != nil {
+			t.Fatalf("match condition %s does not compile: %v", condition.Name, issues.Err())
+		}
+	}
+
+	toMap := func(labels map[string]string) map[string]interface{} {
+		metadata := map[string]interface{}{}
+		if labels != nil {
+			values := map[string]interface{}{}
+			for key, value := range labels {
+				values[key] = value
+			}
+			metadata["labels"] = values
+		}
+		return map[string]interface{}{"metadata": metadata}
+	}
+	for name, tc := range assignTestCases {
+		t.Run(name, func(t *testing.T) {
+			groups := []interface{}{}
+			for _, group := range tc.req.Groups {
+				groups = append(groups, group)
+			}
+			activation := map[string]interface{}{
+				"object":          toMap(tc.req.Labels),
+				"namespaceObject": toMap(tc.req.NamespaceLabels),
+				"request":         map[string]interface{}{"userInfo": map[string]interface{}{"groups": groups}},
+			}
+			got := map[string]string{}
+			for variable, label := range map[string]string{"queueName": QueueNameLabel, "priorityClass": PriorityClassLabel} {
+				value, _, err := programs[variable].Eval(activation)
+				if err != nil {
+					t.Fatalf("variable %s failed: %v", variable, err)
+				}
+				if value.Value().(string) != "" && tc.req.Labels[label] != value.Value().(string) {
+					got[label] = value.Value().(string)
+				}
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("unexpected labels (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResources(t *testing.T) {
+	cfg := kueue.KueueConfiguration{
+		Integrations: kueue.Integrations{
+			Frameworks: []kueue.KueueIntegration{
+				kueue.KueueIntegrationRayJob,
+				kueue.KueueIntegrationBatchJob,
+				kueue.KueueIntegrationRayCluster,
+			},
+			ExternalFrameworks: []kueue.ExternalFramework{
+				{Group: "example.com", Resource: "jobs", Version: "v1"},
+				{Group: "batch", Resource: "jobs", Version: "v1"},
+			},
+		},
+	}
+
+	resources := Resources(cfg)
+	wantResources := []schema.GroupResource{
+		{Group: "batch", Resource: "jobs"},
+		{Group: "example.com", Resource: "jobs"},
+		{Group: "ray.io", Resource: "rayclusters"},
+		{Group: "ray.io", Resource: "rayjobs"},
+	}
+	if diff := cmp.Diff(wantResources, resources); diff != "" {
+		t.Errorf("unexpected resources (-want,+got):\n%s", diff)
+	}
+
+	rules := Rules(resources)
+	wantRules := []admissionregistrationv1.RuleWithOperations{}
+	for _, rule := range []struct {
+		group     string
+		resources []string
+	}{
+		{group: "batch", resources: []string{"jobs"}},
+		{group: "example.com", resources: []string{"jobs"}},
+		{group: "ray.io", resources: []string{"rayclusters", "rayjobs"}},
+	} {
+		wantRules = append(wantRules, admissionregistrationv1.RuleWithOperations{
+			Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Create},
+			Rule: admissionregistrationv1.Rule{
+				APIGroups:   []string{rule.group},
+				APIVersions: []string{"*"},
+				Resources:   rule.resources,
+				Scope:       ptr.To(admissionregistrationv1.NamespacedScope),
+			},
+		})
+	}
+	if diff := cmp.Diff(wantRules, rules); diff != "" {
+		t.Errorf("unexpected rules (-want,+got):\n%s", diff)
+	}
+}
+
+func TestReview(t *testing.T) {
+	handler := &Handler{
+		Rules: testRules,
+		NamespaceLabels: func(string) (map[string]string, error) {
+			return map[string]string{"team": "research"}, nil
+		},
+	}
+
+	testCases := map[string]struct {
+		operation admissionv1.Operation
+		object    string
+		wantPatch []jsonPatchOperation
+	}{
+		"workload without labels": {
+			operation: admissionv1.Create,
+			object:    `{"metadata":{"name":"job"}}`,
+			wantPatch: []jsonPatchOperation{
+				{Op: "add", Path: "/metadata/labels", Value: map[string]interface{}{QueueNameLabel: "research", PriorityClassLabel: "high"}},
+			},
+		},
+		"workload with labels": {
+			operation: admissionv1.Create,
+			object:    `{"metadata":{"name":"job","labels":{"app":"job"}}}`,
+			wantPatch: []jsonPatchOperation{
+				{Op: "add", Path: "/metadata/labels/kueue.x-k8s.io~1priority-class", Value: "high"},
+				{Op: "add", Path: "/metadata/labels/kueue.x-k8s.io~1queue-name", Value: "research"},
+			},
+		},
+		"updates are not mutated": {
+			operation: admissionv1.Update,
+			object:    `{"metadata":{"name":"job"}}`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			response := handler.Review(&admissionv1.AdmissionRequest{
+				Operation: tc.operation,
+				Namespace: "research",
+				Object:    runtime.RawExtension{Raw: []byte(tc.object)},
+				UserInfo:  authenticationv1.UserInfo{Groups: []string{"ml-admins"}},
+			})
+			if !response.Allowed {
+				t.Errorf("the workload was not allowed")
+			}
+			var gotPatch []jsonPatchOperation
+			if response.Patch != nil {
+				// Decode the values the same way as the expected patch.
+				if err := json.Unmarshal(response.Patch, &gotPatch); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+			if diff := cmp.Diff(tc.wantPatch, gotPatch); diff != "" {
+				t.Errorf("unexpected patch (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queueassignment
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+
+	kueue "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+)
+
+// maxRequestSize bounds the size of the AdmissionReviews the webhook reads.
+const maxRequestSize = 3 * 1024 * 1024
+
+// Handler serves the queue assignment webhook.
+type Handler struct {
+	// Rules returns the rules to enforce. No label is set while it returns none.
+	Rules func() []kueue.QueueAssignmentRule
+	// NamespaceLabels returns the labels of the namespace.
+	NamespaceLabels func(name string) (map[string]string, error)
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestSize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	review := admissionv1.AdmissionReview{}
+	if err := json.Unmarshal(body, &review); err != nil || review.Request == nil {
+		http.Error(w, "invalid AdmissionReview", http.StatusBadRequest)
+		return
+	}
+
+	review.Response = h.Review(review.Request)
+	review.Response.UID = review.Request.UID
+	review.Request = nil
+	data, err := json.Marshal(review)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(data); err != nil {
+		klog.Errorf("failed to write the queue assignment response: %v", err)
+	}
+}
+
+// Review returns the response to an admission request, with a patch setting the labels
+// assigned by the rules.
+func (h *Handler) Review(req *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	response := &admissionv1.AdmissionResponse{Allowed: true}
+	if req.Operation != admissionv1.Create {
+		return response
+	}
+	rules := h.Rules()
+	if len(rules) == 0 {
+		return response
+	}
+
+	obj := metav1.PartialObjectMetadata{}
+	if err := json.Unmarshal(req.Object.Raw, &obj); err != nil {
+		// The failure policy is Ignore, the workload is admitted unchanged.
+		klog.Errorf("failed to decode %s %s/%s: %v", req.Kind.Kind, req.Namespace, req.Name, err)
+		return response
+	}
+	namespaceLabels, err := h.NamespaceLabels(req.Namespace)
+	if err != nil {
+		klog.Errorf("failed to get the labels of namespace %s: %v", req.Namespace, err)
+		return response
+	}
+
+	assigned := Assign(rules, Request{
+		NamespaceLabels: namespaceLabels,
+		Groups:          req.UserInfo.Groups,
+		Labels:          obj.Labels,
+	})
+	if len(assigned) == 0 {
+		return response
+	}
+	patch, err := json.Marshal(labelsPatch(obj.Labels, assigned))
+	if err != nil {
+		klog.Errorf("failed to build the queue assignment patch: %v", err)
+		return response
+	}
+	response.Patch = patch
+	response.PatchType = ptr.To(admissionv1.PatchTypeJSONPatch)
+	return response
+}
+
+type jsonPatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value"`
+}
+
+// labelsPatch returns the JSON patch setting the assigned labels.
+func labelsPatch(labels, assigned map[string]string) []jsonPatchOperation {
+	if labels == nil {
+		return []jsonPatchOperation{{Op: "add", Path: "/metadata/labels", Value: assigned}}
+	}
+	operations := []jsonPatchOperation{}
+	for _, key := range slices.Sorted(maps.Keys(assigned)) {
+		operations = append(operations, jsonPatchOperation{
+			Op:    "add",
+			Path:  fmt.Sprintf("/metadata/labels/%s", escapeJSONPointer(key)),
+			Value: assigned[key],
+		})
+	}
+	return operations
+}
+
+func escapeJSONPointer(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, "~", "~0"), "/", "~1")
+}
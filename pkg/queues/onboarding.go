@@ -0,0 +1,88 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queues
+
+import (
+	"slices"
+
+	kueue "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	// DefaultLocalQueueName is the name of the LocalQueue created in managed namespaces.
+	// Kueue assigns workloads without a queue-name label to the LocalQueue with this name.
+	DefaultLocalQueueName = "default"
+	// ClusterQueueAnnotation selects the ClusterQueue of the default LocalQueue of a
+	// namespace. It takes precedence over the mappings of the Kueue CR.
+	ClusterQueueAnnotation = "kueue.openshift.io/cluster-queue"
+	// OnboardingManagedByValue is the value of kueue.ManagedByLabel on the default LocalQueues.
+	// It differs from kueue.ManagedByValue so that the default LocalQueues and the queues
+	// declared in the queues section are kept in sync independently.
+	OnboardingManagedByValue = "kueue-operator-namespace-onboarding"
+)
+
+// IsManagedNamespace reports whether the namespace is opted in to Kueue.
+func IsManagedNamespace(ns *corev1.Namespace) bool {
+	return ns.Labels[kueue.ManagedNamespaceLabel] == "true"
+}
+
+// OnboardingSelector selects the default LocalQueues created by the operator.
+func OnboardingSelector() string {
+	return kueue.ManagedByLabel + "=" + OnboardingManagedByValue
+}
+
+// ClusterQueueFor returns the ClusterQueue of the default LocalQueue of the namespace,
+// or an empty string when no ClusterQueue is selected.
+func ClusterQueueFor(onboarding kueue.NamespaceOnboarding, ns *corev1.Namespace) string {
+	if clusterQueue := ns.Annotations[ClusterQueueAnnotation]; clusterQueue != "" {
+		return clusterQueue
+	}
+	for _, mapping := range onboarding.ClusterQueueMappings {
+		if labels.SelectorFromSet(mapping.NamespaceLabels).Matches(labels.Set(ns.Labels)) {
+			return mapping.ClusterQueue
+		}
+	}
+	return onboarding.DefaultClusterQueue
+}
+
+// BuildDefaultLocalQueues returns the default LocalQueue of each of the given namespaces
+// that selects a ClusterQueue. None is returned when the queues section already declares
+// a LocalQueue named default, which is then created in every managed namespace.
+func BuildDefaultLocalQueues(queues kueue.Queues, namespaces []*corev1.Namespace) ([]Object, error) {
+	objects := []Object{}
+	if slices.ContainsFunc(queues.LocalQueues, func(template kueue.LocalQueueTemplate) bool {
+		return template.Name == DefaultLocalQueueName
+	}) {
+		return objects, nil
+	}
+	for _, ns := range namespaces {
+		clusterQueue := ClusterQueueFor(queues.NamespaceOnboarding, ns)
+		if clusterQueue == "" {
+			continue
+		}
+		localQueue := BuildLocalQueue(kueue.LocalQueueTemplate{Name: DefaultLocalQueueName, ClusterQueue: clusterQueue}, ns.Name)
+		localQueue.Labels[kueue.ManagedByLabel] = OnboardingManagedByValue
+		obj, err := newObject(LocalQueuesGVR, localQueue)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, obj)
+	}
+	return objects, nil
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queues
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kueue "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+)
+
+func namespace(name string, labels, annotations map[string]string) *corev1.Namespace {
+	return &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels, Annotations: annotations},
+	}
+}
+
+func TestClusterQueueFor(t *testing.T) {
+	onboarding := kueue.NamespaceOnboarding{
+		ClusterQueueMappings: []kueue.ClusterQueueMapping{
+			{NamespaceLabels: map[string]string{"team": "research", "tier": "gpu"}, ClusterQueue: "research-gpu"},
+			{NamespaceLabels: map[string]string{"team": "research"}, ClusterQueue: "research"},
+		},
+		DefaultClusterQueue: "shared",
+	}
+
+	testCases := map[string]struct {
+		onboarding kueue.NamespaceOnboarding
+		namespace  *corev1.Namespace
+		want       string
+	}{
+		"annotation takes precedence over the mappings": {
+			onboarding: onboarding,
+			namespace:  namespace("team-a", map[string]string{"team": "research"}, map[string]string{ClusterQueueAnnotation: "team-a"}),
+			want:       "team-a",
+		},
+		"first matching mapping": {
+			onboarding: onboarding,
+			namespace:  namespace("team-a", map[string]string{"team": "research", "tier": "gpu"}, nil),
+			want:       "research-gpu",
+		},
+		"mapping requires all labels": {
+			onboarding: onboarding,
+			namespace:  namespace("team-a", map[string]string{"team": "research", "tier": "cpu"}, nil),
+			want:       "research",
+		},
+		"default ClusterQueue": {
+			onboarding: onboarding,
+			namespace:  namespace("team-a", map[string]string{"tier": "gpu"}, nil),
+			want:       "shared",
+		},
+		"no ClusterQueue selected": {
+			namespace: namespace("team-a", map[string]string{"tier": "gpu"}, nil),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := ClusterQueueFor(tc.onboarding, tc.namespace); got != tc.want {
+				t.Errorf("ClusterQueueFor() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBuildDefaultLocalQueues(t *testing.T) {
+	namespaces := []*corev1.Namespace{
+		namespace("team-a", nil, map[string]string{ClusterQueueAnnotation: "team-a"}),
+		namespace("team-b", nil, nil),
+	}
+
+	testCases := map[string]struct {
+		queues kueue.Queues
+		want   map[string]string
+	}{
+		"namespaces without a ClusterQueue are skipped": {
+			want: map[string]string{"LocalQueue team-a/default": "team-a"},
+		},
+		"default ClusterQueue": {
+			queues: kueue.Queues{
+				NamespaceOnboarding: kueue.NamespaceOnboarding{DefaultClusterQueue: "shared"},
+			},
+			want: map[string]string{
+				"LocalQueue team-a/default": "team-a",
+				"LocalQueue team-b/default": "shared",
+			},
+		},
+		"LocalQueue named default declared in the queues section": {
+			queues: kueue.Queues{
+				LocalQueues:         []kueue.LocalQueueTemplate{{Name: DefaultLocalQueueName, ClusterQueue: "cluster-queue"}},
+				NamespaceOnboarding: kueue.NamespaceOnboarding{DefaultClusterQueue: "shared"},
+			},
+			want: map[string]string{},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			objects, err := BuildDefaultLocalQueues(tc.queues, namespaces)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got := map[string]string{}
+			for _, obj := range objects {
+				if obj.Object.GetLabels()[kueue.ManagedByLabel] != OnboardingManagedByValue {
+					t.Errorf("%s is not labeled as created by namespace onboarding", obj.Key())
+				}
+				got[obj.Key()] = obj.Object.Object["spec"].(map[string]interface{})["clusterQueue"].(string)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("unexpected LocalQueues (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
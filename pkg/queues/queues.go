@@ -0,0 +1,306 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package queues builds the Kueue queues declared in the queues section of the
+// Kueue operator API.
+package queues
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+
+	kueue "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kueuev1beta2 "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+)
+
+const (
+	// SpecHashAnnotation holds the hash of the spec the operator last applied, so that
+	// changes to the queues section are told apart from changes made by users.
+	SpecHashAnnotation = "kueue.openshift.io/spec-hash"
+)
+
+var (
+	ResourceFlavorsGVR = kueuev1beta2.GroupVersion.WithResource("resourceflavors")
+	CohortsGVR         = kueuev1beta2.GroupVersion.WithResource("cohorts")
+	ClusterQueuesGVR   = kueuev1beta2.GroupVersion.WithResource("clusterqueues")
+	LocalQueuesGVR     = kueuev1beta2.GroupVersion.WithResource("localqueues")
+)
+
+// ManagedNamespaceSelector selects the namespaces opted in to Kueue.
+func ManagedNamespaceSelector() *metav1.LabelSelector {
+	return &metav1.LabelSelector{
+		MatchLabels: map[string]string{kueue.ManagedNamespaceLabel: "true"},
+	}
+}
+
+// ManagedBySelector selects the queues created by the operator.
+func ManagedBySelector() string {
+	return kueue.ManagedByLabel + "=" + kueue.ManagedByValue
+}
+
+func objectMeta(name, namespace string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      name,
+		Namespace: namespace,
+		Labels:    map[string]string{kueue.ManagedByLabel: kueue.ManagedByValue},
+	}
+}
+
+// BuildResourceFlavor returns the ResourceFlavor described by the template.
+func BuildResourceFlavor(template kueue.ResourceFlavorTemplate) *kueuev1beta2.ResourceFlavor {
+	return &kueuev1beta2.ResourceFlavor{
+		TypeMeta:   metav1.TypeMeta{APIVersion: kueuev1beta2.GroupVersion.String(), Kind: "ResourceFlavor"},
+		ObjectMeta: objectMeta(template.Name, ""),
+		Spec: kueuev1beta2.ResourceFlavorSpec{
+			NodeLabels: template.NodeLabels,
+		},
+	}
+}
+
+// BuildCohort returns the Cohort described by the template.
+func BuildCohort(template kueue.CohortTemplate) *kueuev1beta2.Cohort {
+	return &kueuev1beta2.Cohort{
+		TypeMeta:   metav1.TypeMeta{APIVersion: kueuev1beta2.GroupVersion.String(), Kind: "Cohort"},
+		ObjectMeta: objectMeta(template.Name, ""),
+		Spec: kueuev1beta2.CohortSpec{
+			ParentName: kueuev1beta2.CohortReference(template.ParentName),
+		},
+	}
+}
+
+// BuildClusterQueue returns the ClusterQueue described by the template. The ClusterQueue
+// admits workloads from the namespaces opted in to Kueue.
+func BuildClusterQueue(template kueue.ClusterQueueTemplate) *kueuev1beta2.ClusterQueue {
+	resourceGroups := make([]kueuev1beta2.ResourceGroup, 0, len(template.ResourceGroups))
+	for _, group := range template.ResourceGroups {
+		coveredResources := make([]corev1.ResourceName, 0, len(group.CoveredResources))
+		for _, name := range group.CoveredResources {
+			coveredResources = append(coveredResources, corev1.ResourceName(name))
+		}
+		flavors := make([]kueuev1beta2.FlavorQuotas, 0, len(group.Flavors))
+		for _, flavor := range group.Flavors {
+			resources := make([]kueuev1beta2.ResourceQuota, 0, len(flavor.Resources))
+			for _, quota := range flavor.Resources {
+				resources = append(resources, kueuev1beta2.ResourceQuota{
+					Name:           corev1.ResourceName(quota.Name),
+					NominalQuota:   quota.NominalQuota,
+					BorrowingLimit: quota.BorrowingLimit,
+					LendingLimit:   quota.LendingLimit,
+				})
+			}
+			flavors = append(flavors, kueuev1beta2.FlavorQuotas{
+				Name:      kueuev1beta2.ResourceFlavorReference(flavor.Name),
+				Resources: resources,
+			})
+		}
+		resourceGroups = append(resourceGroups, kueuev1beta2.ResourceGroup{
+			CoveredResources: coveredResources,
+			Flavors:          flavors,
+		})
+	}
+
+	return &kueuev1beta2.ClusterQueue{
+		TypeMeta:   metav1.TypeMeta{APIVersion: kueuev1beta2.GroupVersion.String(), Kind: "ClusterQueue"},
+		ObjectMeta: objectMeta(template.Name, ""),
+		Spec: kueuev1beta2.ClusterQueueSpec{
+			CohortName:        kueuev1beta2.CohortReference(template.CohortName),
+			NamespaceSelector: ManagedNamespaceSelector(),
+			ResourceGroups:    resourceGroups,
+		},
+	}
+}
+
+// BuildLocalQueue returns the LocalQueue described by the template in the given namespace.
+func BuildLocalQueue(template kueue.LocalQueueTemplate, namespace string) *kueuev1beta2.LocalQueue {
+	return &kueuev1beta2.LocalQueue{
+		TypeMeta:   metav1.TypeMeta{APIVersion: kueuev1beta2.GroupVersion.String(), Kind: "LocalQueue"},
+		ObjectMeta: objectMeta(template.Name, namespace),
+		Spec: kueuev1beta2.LocalQueueSpec{
+			ClusterQueue: kueuev1beta2.ClusterQueueReference(template.ClusterQueue),
+		},
+	}
+}
+
+// Object is a queue to create, with the resource it is served by.
+type Object struct {
+	GVR    schema.GroupVersionResource
+	Object *unstructured.Unstructured
+}
+
+// Key identifies the object in messages, e.g. LocalQueue team-a/default.
+func (o Object) Key() string {
+	if o.Object.GetNamespace() != "" {
+		return fmt.Sprintf("%s %s/%s", o.Object.GetKind(), o.Object.GetNamespace(), o.Object.GetName())
+	}
+	return fmt.Sprintf("%s %s", o.Object.GetKind(), o.Object.GetName())
+}
+
+// Build returns every queue declared in the queues section, with the LocalQueues stamped
+// into each of the given namespaces. Objects are ordered so that the objects they
+// reference are created first.
+func Build(queues kueue.Queues, namespaces []string) ([]Object, error) {
+	objects := []Object{}
+	add := func(gvr schema.GroupVersionResource, obj runtime.Object) error {
+		o, err := newObject(gvr, obj)
+		if err != nil {
+			return err
+		}
+		objects = append(objects, o)
+		return nil
+	}
+
+	for _, template := range queues.ResourceFlavors {
+		if err := add(ResourceFlavorsGVR, BuildResourceFlavor(template)); err != nil {
+			return nil, err
+		}
+	}
+	for _, template := range queues.Cohorts {
+		if err := add(CohortsGVR, BuildCohort(template)); err != nil {
+			return nil, err
+		}
+	}
+	for _, template := range queues.ClusterQueues {
+		if err := add(ClusterQueuesGVR, BuildClusterQueue(template)); err != nil {
+			return nil, err
+		}
+	}
+	for _, namespace := range namespaces {
+		for _, template := range queues.LocalQueues {
+			if err := add(LocalQueuesGVR, BuildLocalQueue(template, namespace)); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return objects, nil
+}
+
+// newObject converts obj to an Object stamped with the hash of its spec.
+func newObject(gvr schema.GroupVersionResource, obj runtime.Object) (Object, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return Object{}, err
+	}
+	u := &unstructured.Unstructured{Object: content}
+	// The converter keeps empty status and creationTimestamp fields, which are
+	// not part of the desired state.
+	unstructured.RemoveNestedField(u.Object, "status")
+	unstructured.RemoveNestedField(u.Object, "metadata", "creationTimestamp")
+	hash, err := specHash(u)
+	if err != nil {
+		return Object{}, err
+	}
+	u.SetAnnotations(map[string]string{SpecHashAnnotation: hash})
+	return Object{GVR: gvr, Object: u}, nil
+}
+
+func specHash(obj *unstructured.Unstructured) (string, error) {
+	spec, _, _ := unstructured.NestedFieldNoCopy(obj.Object, "spec")
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", sha256.Sum256(data)), nil
+}
+
+// IsOutdated reports whether the live object was created from a different version of
+// its template.
+func IsOutdated(desired, live *unstructured.Unstructured) bool {
+	return desired.GetAnnotations()[SpecHashAnnotation] != live.GetAnnotations()[SpecHashAnnotation]
+}
+
+// RequiresRecreate reports whether the live object must be deleted and created again to
+// match the desired object, because the fields that differ are immutable. This is the
+// case of the ClusterQueue of a LocalQueue.
+func RequiresRecreate(desired, live *unstructured.Unstructured) bool {
+	if desired.GetKind() != "LocalQueue" {
+		return false
+	}
+	desiredClusterQueue, _, _ := unstructured.NestedString(desired.Object, "spec", "clusterQueue")
+	liveClusterQueue, _, _ := unstructured.NestedString(live.Object, "spec", "clusterQueue")
+	return desiredClusterQueue != liveClusterQueue
+}
+
+// Merge returns a copy of the live object with the spec, labels and annotations of the
+// desired object.
+func Merge(desired, live *unstructured.Unstructured) *unstructured.Unstructured {
+	merged := live.DeepCopy()
+	merged.Object["spec"] = runtime.DeepCopyJSONValue(desired.Object["spec"])
+	labels := merged.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	maps.Copy(labels, desired.GetLabels())
+	merged.SetLabels(labels)
+	annotations := merged.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	maps.Copy(annotations, desired.GetAnnotations())
+	merged.SetAnnotations(annotations)
+	return merged
+}
+
+// HasDrifted reports whether the spec of the live object differs from the desired spec.
+// Fields that are not part of the desired spec, e.g. defaulted by Kueue, are ignored.
+func HasDrifted(desired, live *unstructured.Unstructured) bool {
+	desiredSpec, _, _ := unstructured.NestedFieldNoCopy(desired.Object, "spec")
+	liveSpec, _, _ := unstructured.NestedFieldNoCopy(live.Object, "spec")
+	return !isSubset(desiredSpec, liveSpec)
+}
+
+// isSubset reports whether every field set in desired has the same value in live.
+// Lists must have the same length, and each item must be a subset of the live item.
+func isSubset(desired, live interface{}) bool {
+	switch d := desired.(type) {
+	case map[string]interface{}:
+		l, ok := live.(map[string]interface{})
+		if !ok {
+			return len(d) == 0 && live == nil
+		}
+		for key, value := range d {
+			if !isSubset(value, l[key]) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		l, ok := live.([]interface{})
+		if !ok {
+			return len(d) == 0 && live == nil
+		}
+		if len(d) != len(l) {
+			return false
+		}
+		for i := range d {
+			if !isSubset(d[i], l[i]) {
+				return false
+			}
+		}
+		return true
+	case nil:
+		return true
+	default:
+		return reflect.DeepEqual(desired, live)
+	}
+}
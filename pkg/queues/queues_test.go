@@ -0,0 +1,218 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queues
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+
+	kueue "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+)
+
+func testQueues() kueue.Queues {
+	return kueue.Queues{
+		ResourceFlavors: []kueue.ResourceFlavorTemplate{
+			{Name: "default-flavor"},
+		},
+		Cohorts: []kueue.CohortTemplate{
+			{Name: "research"},
+		},
+		ClusterQueues: []kueue.ClusterQueueTemplate{
+			{
+				Name:       "cluster-queue",
+				CohortName: "research",
+				ResourceGroups: []kueue.QueueResourceGroup{
+					{
+						CoveredResources: []string{"cpu", "memory"},
+						Flavors: []kueue.FlavorQuotas{
+							{
+								Name: "default-flavor",
+								Resources: []kueue.ResourceQuota{
+									{Name: "cpu", NominalQuota: resource.MustParse("10"), BorrowingLimit: ptr.To(resource.MustParse("2"))},
+									{Name: "memory", NominalQuota: resource.MustParse("64Gi")},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		LocalQueues: []kueue.LocalQueueTemplate{
+			{Name: "default", ClusterQueue: "cluster-queue"},
+		},
+	}
+}
+
+func TestBuild(t *testing.T) {
+	objects, err := Build(testQueues(), []string{"team-a", "team-b"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	keys := []string{}
+	for _, obj := range objects {
+		keys = append(keys, obj.Key())
+		if obj.Object.GetLabels()[kueue.ManagedByLabel] != kueue.ManagedByValue {
+			t.Errorf("%s is missing the managed-by label", obj.Key())
+		}
+		if obj.Object.GetAnnotations()[SpecHashAnnotation] == "" {
+			t.Errorf("%s is missing the spec hash annotation", obj.Key())
+		}
+		if _, found := obj.Object.Object["status"]; found {
+			t.Errorf("%s has a status", obj.Key())
+		}
+	}
+	wantKeys := []string{
+		"ResourceFlavor default-flavor",
+		"Cohort research",
+		"ClusterQueue cluster-queue",
+		"LocalQueue team-a/default",
+		"LocalQueue team-b/default",
+	}
+	if diff := cmp.Diff(wantKeys, keys); diff != "" {
+		t.Errorf("unexpected objects (-want,+got):\n%s", diff)
+	}
+
+	clusterQueue := objects[2].Object
+	wantSpec := map[string]interface{}{
+		"cohortName": "research",
+		"namespaceSelector": map[string]interface{}{
+			"matchLabels": map[string]interface{}{"kueue.openshift.io/managed": "true"},
+		},
+		"resourceGroups": []interface{}{
+			map[string]interface{}{
+				"coveredResources": []interface{}{"cpu", "memory"},
+				"flavors": []interface{}{
+					map[string]interface{}{
+						"name": "default-flavor",
+						"resources": []interface{}{
+							map[string]interface{}{"name": "cpu", "nominalQuota": "10", "borrowingLimit": "2"},
+							map[string]interface{}{"name": "memory", "nominalQuota": "64Gi"},
+						},
+					},
+				},
+			},
+		},
+	}
+	if diff := cmp.Diff(wantSpec, clusterQueue.Object["spec"]); diff != "" {
+		t.Errorf("unexpected ClusterQueue spec (-want,+got):\n%s", diff)
+	}
+}
+
+func TestHasDrifted(t *testing.T) {
+	objects, err := Build(testQueues(), []string{"team-a"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	desired := objects[2].Object
+
+	testCases := map[string]struct {
+		mutate func(live *unstructured.Unstructured)
+		want   bool
+	}{
+		"unchanged": {
+			mutate: func(*unstructured.Unstructured) {},
+		},
+		"fields defaulted by Kueue are ignored": {
+			mutate: func(live *unstructured.Unstructured) {
+				_ = unstructured.SetNestedField(live.Object, "BestEffortFIFO", "spec", "queueingStrategy")
+			},
+		},
+		"changed quota": {
+			mutate: func(live *unstructured.Unstructured) {
+				groups, _, _ := unstructured.NestedSlice(live.Object, "spec", "resourceGroups")
+				groups[0].(map[string]interface{})["coveredResources"] = []interface{}{"cpu"}
+				_ = unstructured.SetNestedSlice(live.Object, groups, "spec", "resourceGroups")
+			},
+			want: true,
+		},
+		"removed cohort": {
+			mutate: func(live *unstructured.Unstructured) {
+				unstructured.RemoveNestedField(live.Object, "spec", "cohortName")
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			live := desired.DeepCopy()
+			tc.mutate(live)
+			if got := HasDrifted(desired, live); got != tc.want {
+				t.Errorf("HasDrifted() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMerge(t *testing.T) {
+	objects, err := Build(testQueues(), []string{"team-a"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	desired := objects[3].Object
+
+	live := desired.DeepCopy()
+	live.SetResourceVersion("42")
+	live.SetLabels(map[string]string{kueue.ManagedByLabel: kueue.ManagedByValue, "team": "a"})
+	live.SetAnnotations(map[string]string{SpecHashAnnotation: "outdated"})
+	_ = unstructured.SetNestedField(live.Object, "other-queue", "spec", "clusterQueue")
+
+	if !IsOutdated(desired, live) {
+		t.Errorf("expected the live object to be outdated")
+	}
+	merged := Merge(desired, live)
+	if merged.GetResourceVersion() != "42" {
+		t.Errorf("the resource version was not preserved")
+	}
+	if merged.GetLabels()["team"] != "a" {
+		t.Errorf("the user labels were not preserved")
+	}
+	if IsOutdated(desired, merged) || HasDrifted(desired, merged) {
+		t.Errorf("the merged object does not match the desired object")
+	}
+	if clusterQueue, _, _ := unstructured.NestedString(live.Object, "spec", "clusterQueue"); clusterQueue != "other-queue" {
+		t.Errorf("the live object was modified")
+	}
+}
+
+func TestRequiresRecreate(t *testing.T) {
+	objects, err := Build(testQueues(), []string{"team-a"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	clusterQueue, localQueue := objects[2].Object, objects[3].Object
+
+	live := localQueue.DeepCopy()
+	if RequiresRecreate(localQueue, live) {
+		t.Errorf("an unchanged LocalQueue does not need to be recreated")
+	}
+	_ = unstructured.SetNestedField(live.Object, "other-queue", "spec", "clusterQueue")
+	if !RequiresRecreate(localQueue, live) {
+		t.Errorf("a LocalQueue pointing to another ClusterQueue needs to be recreated")
+	}
+
+	live = clusterQueue.DeepCopy()
+	_ = unstructured.SetNestedField(live.Object, "other-cohort", "spec", "cohortName")
+	if RequiresRecreate(clusterQueue, live) {
+		t.Errorf("a ClusterQueue can be updated in place")
+	}
+}
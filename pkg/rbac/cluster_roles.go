@@ -0,0 +1,142 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"fmt"
+
+	kueue "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+const (
+	aggregateToAdminLabel = "rbac.authorization.k8s.io/aggregate-to-admin"
+	aggregateToEditLabel  = "rbac.authorization.k8s.io/aggregate-to-edit"
+	aggregateToViewLabel  = "rbac.authorization.k8s.io/aggregate-to-view"
+)
+
+// frameworkClusterRoles maps each integration to the resource name used in
+// its kueue-<resource>-editor-role and kueue-<resource>-viewer-role ClusterRoles.
+// Integrations without dedicated ClusterRoles are not listed.
+var frameworkClusterRoles = map[kueue.KueueIntegration]string{
+	kueue.KueueIntegrationBatchJob:         "job",
+	kueue.KueueIntegrationRayJob:           "rayjob",
+	kueue.KueueIntegrationRayCluster:       "raycluster",
+	kueue.KueueIntegrationRayService:       "rayservice",
+	kueue.KueueIntegrationJobSet:           "jobset",
+	kueue.KueueIntegrationMPIJob:           "mpijob",
+	kueue.KueueIntegrationPaddleJob:        "paddlejob",
+	kueue.KueueIntegrationPyTorchJob:       "pytorchjob",
+	kueue.KueueIntegrationTFJob:            "tfjob",
+	kueue.KueueIntegrationTrainJob:         "trainjob",
+	kueue.KueueIntegrationXGBoostJob:       "xgboostjob",
+	kueue.KueueIntegrationJaxJob:           "jaxjob",
+	kueue.KueueIntegrationLeaderWorkerSet:  "leaderworkerset",
+	kueue.KueueIntegrationSparkApplication: "sparkapplication",
+}
+
+// retiredFrameworkClusterRoles are shipped by upstream Kueue for frameworks that
+// are no longer Kueue integrations. They are never installed.
+var retiredFrameworkClusterRoles = []string{"mxjob"}
+
+// namespacedRoleAggregation lists the ClusterRoles for namespaced Kueue APIs that
+// are aggregated into the default OpenShift roles, and the labels used to do so.
+var namespacedRoleAggregation = map[string][]string{
+	"kueue-localqueue-editor-role":           {aggregateToAdminLabel},
+	"kueue-localqueue-viewer-role":           {aggregateToViewLabel},
+	"kueue-workload-viewer-role":             {aggregateToViewLabel},
+	"kueue-pending-workloads-lq-viewer-role": {aggregateToViewLabel},
+}
+
+func editorRoleName(resource string) string {
+	return fmt.Sprintf("kueue-%s-editor-role", resource)
+}
+
+func viewerRoleName(resource string) string {
+	return fmt.Sprintf("kueue-%s-viewer-role", resource)
+}
+
+// frameworkForClusterRole returns the resource name of the integration owning the
+// given ClusterRole, and whether the ClusterRole is an integration specific role.
+func frameworkForClusterRole(name string) (string, bool) {
+	for _, resource := range frameworkClusterRoles {
+		if name == editorRoleName(resource) || name == viewerRoleName(resource) {
+			return resource, true
+		}
+	}
+	for _, resource := range retiredFrameworkClusterRoles {
+		if name == editorRoleName(resource) || name == viewerRoleName(resource) {
+			return resource, true
+		}
+	}
+	return "", false
+}
+
+// IsClusterRoleEnabled reports whether the ClusterRole with the given name should be
+// installed for the given integrations.
+// ClusterRoles for the Kueue APIs are always installed, while the editor and viewer
+// ClusterRoles of an integration are only installed when that integration is enabled.
+func IsClusterRoleEnabled(name string, frameworks []kueue.KueueIntegration) bool {
+	resource, isFrameworkRole := frameworkForClusterRole(name)
+	if !isFrameworkRole {
+		return true
+	}
+	for _, fw := range frameworks {
+		if frameworkClusterRoles[fw] == resource {
+			return true
+		}
+	}
+	return false
+}
+
+// SetDefaultRoleAggregation labels the ClusterRole so it is aggregated into the
+// OpenShift admin, edit and view ClusterRoles when enabled.
+// Integration editor roles aggregate into edit (and therefore admin), viewer roles
+// aggregate into view. When disabled, the labels are marked for removal so that
+// roles aggregated by a previous configuration are detached again.
+func SetDefaultRoleAggregation(role *rbacv1.ClusterRole, policy kueue.DefaultRoleAggregation) {
+	labels := aggregationLabelsForClusterRole(role.Name)
+	if len(labels) == 0 {
+		return
+	}
+	if role.Labels == nil {
+		role.Labels = map[string]string{}
+	}
+	for _, label := range labels {
+		if policy == kueue.DefaultRoleAggregationEnabled {
+			role.Labels[label] = "true"
+		} else {
+			// A trailing dash removes the label when the ClusterRole is applied.
+			role.Labels[label+"-"] = ""
+		}
+	}
+}
+
+func aggregationLabelsForClusterRole(name string) []string {
+	if labels, ok := namespacedRoleAggregation[name]; ok {
+		return labels
+	}
+	for _, resource := range frameworkClusterRoles {
+		switch name {
+		case editorRoleName(resource):
+			return []string{aggregateToEditLabel}
+		case viewerRoleName(resource):
+			return []string{aggregateToViewLabel}
+		}
+	}
+	return nil
+}
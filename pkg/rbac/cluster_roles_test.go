@@ -0,0 +1,159 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kueue "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+)
+
+func TestIsClusterRoleEnabled(t *testing.T) {
+	frameworks := []kueue.KueueIntegration{
+		kueue.KueueIntegrationBatchJob,
+		kueue.KueueIntegrationRayJob,
+		kueue.KueueIntegrationPod,
+	}
+
+	testCases := map[string]struct {
+		role string
+		want bool
+	}{
+		"kueue api role is always enabled": {
+			role: "kueue-clusterqueue-editor-role",
+			want: true,
+		},
+		"manager role is always enabled": {
+			role: "kueue-manager-role",
+			want: true,
+		},
+		"editor role of an enabled integration": {
+			role: "kueue-job-editor-role",
+			want: true,
+		},
+		"viewer role of an enabled integration": {
+			role: "kueue-rayjob-viewer-role",
+			want: true,
+		},
+		"editor role of a disabled integration": {
+			role: "kueue-pytorchjob-editor-role",
+			want: false,
+		},
+		"viewer role of a disabled integration sharing a prefix": {
+			role: "kueue-raycluster-viewer-role",
+			want: false,
+		},
+		"retired framework role": {
+			role: "kueue-mxjob-editor-role",
+			want: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsClusterRoleEnabled(tc.role, frameworks); got != tc.want {
+				t.Errorf("IsClusterRoleEnabled(%q) = %v, want %v", tc.role, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSetDefaultRoleAggregation(t *testing.T) {
+	baseLabels := map[string]string{
+		"app.kubernetes.io/name":          "kueue",
+		"rbac.kueue.x-k8s.io/batch-admin": "true",
+	}
+
+	testCases := map[string]struct {
+		role       string
+		policy     kueue.DefaultRoleAggregation
+		wantLabels map[string]string
+	}{
+		"editor role aggregated into edit": {
+			role:   "kueue-job-editor-role",
+			policy: kueue.DefaultRoleAggregationEnabled,
+			wantLabels: map[string]string{
+				"app.kubernetes.io/name":                      "kueue",
+				"rbac.kueue.x-k8s.io/batch-admin":             "true",
+				"rbac.authorization.k8s.io/aggregate-to-edit": "true",
+			},
+		},
+		"viewer role aggregated into view": {
+			role:   "kueue-jobset-viewer-role",
+			policy: kueue.DefaultRoleAggregationEnabled,
+			wantLabels: map[string]string{
+				"app.kubernetes.io/name":                      "kueue",
+				"rbac.kueue.x-k8s.io/batch-admin":             "true",
+				"rbac.authorization.k8s.io/aggregate-to-view": "true",
+			},
+		},
+		"localqueue editor aggregated into admin": {
+			role:   "kueue-localqueue-editor-role",
+			policy: kueue.DefaultRoleAggregationEnabled,
+			wantLabels: map[string]string{
+				"app.kubernetes.io/name":                       "kueue",
+				"rbac.kueue.x-k8s.io/batch-admin":              "true",
+				"rbac.authorization.k8s.io/aggregate-to-admin": "true",
+			},
+		},
+		"cluster scoped role is never aggregated": {
+			role:       "kueue-clusterqueue-editor-role",
+			policy:     kueue.DefaultRoleAggregationEnabled,
+			wantLabels: baseLabels,
+		},
+		"disabled aggregation removes the labels": {
+			role:   "kueue-job-viewer-role",
+			policy: kueue.DefaultRoleAggregationDisabled,
+			wantLabels: map[string]string{
+				"app.kubernetes.io/name":                       "kueue",
+				"rbac.kueue.x-k8s.io/batch-admin":              "true",
+				"rbac.authorization.k8s.io/aggregate-to-view-": "",
+			},
+		},
+		"default is disabled": {
+			role:   "kueue-localqueue-viewer-role",
+			policy: "",
+			wantLabels: map[string]string{
+				"app.kubernetes.io/name":                       "kueue",
+				"rbac.kueue.x-k8s.io/batch-admin":              "true",
+				"rbac.authorization.k8s.io/aggregate-to-view-": "",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			role := &rbacv1.ClusterRole{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   tc.role,
+					Labels: map[string]string{},
+				},
+			}
+			for k, v := range baseLabels {
+				role.Labels[k] = v
+			}
+			SetDefaultRoleAggregation(role, tc.policy)
+			if diff := cmp.Diff(tc.wantLabels, role.Labels); diff != "" {
+				t.Errorf("unexpected labels (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
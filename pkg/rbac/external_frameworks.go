@@ -0,0 +1,140 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package rbac builds the RBAC objects the operator generates for Kueue.
+package rbac
+
+import (
+	"cmp"
+	"slices"
+
+	kueue "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ExternalFrameworksClusterRoleName is the ClusterRole granting the Kueue
+	// controller access to the configured external frameworks.
+	ExternalFrameworksClusterRoleName = "kueue-external-frameworks-role"
+	// ExternalFrameworksClusterRoleBindingName binds ExternalFrameworksClusterRoleName
+	// to the Kueue controller service account.
+	ExternalFrameworksClusterRoleBindingName = "kueue-external-frameworks-rolebinding"
+
+	kueueServiceAccountName = "kueue-controller-manager"
+)
+
+// externalFrameworkVerbs are the verbs Kueue needs to reconcile an external
+// framework and its status.
+var externalFrameworkVerbs = []string{"get", "list", "watch", "update", "patch"}
+
+// MergeExternalFrameworks returns the external frameworks configured for both
+// the integrations and MultiKueue, without duplicates.
+// The result is sorted by group, resource and version so the generated rules
+// are stable across reconciliations.
+func MergeExternalFrameworks(kueueCfg kueue.KueueConfiguration) []kueue.ExternalFramework {
+	var frameworks []kueue.ExternalFramework
+	frameworks = append(frameworks, kueueCfg.Integrations.ExternalFrameworks...)
+	if kueueCfg.MultiKueue != nil {
+		frameworks = append(frameworks, kueueCfg.MultiKueue.ExternalFrameworks...)
+	}
+
+	slices.SortFunc(frameworks, compareExternalFrameworks)
+	return slices.Compact(frameworks)
+}
+
+func compareExternalFrameworks(a, b kueue.ExternalFramework) int {
+	return cmp.Or(
+		cmp.Compare(a.Group, b.Group),
+		cmp.Compare(a.Resource, b.Resource),
+		cmp.Compare(a.Version, b.Version),
+	)
+}
+
+// BuildExternalFrameworksClusterRole returns the ClusterRole granting the Kueue
+// controller the permissions it needs on every external framework and its status.
+// It returns nil when no external frameworks are configured.
+func BuildExternalFrameworksClusterRole(frameworks []kueue.ExternalFramework) *rbacv1.ClusterRole {
+	if len(frameworks) == 0 {
+		return nil
+	}
+
+	// Rules are keyed by group so that several resources of the same API group
+	// end up in a single rule.
+	resourcesByGroup := map[string][]string{}
+	groups := []string{}
+	for _, fw := range frameworks {
+		if _, ok := resourcesByGroup[fw.Group]; !ok {
+			groups = append(groups, fw.Group)
+		}
+		for _, resource := range []string{fw.Resource, fw.Resource + "/status"} {
+			if !slices.Contains(resourcesByGroup[fw.Group], resource) {
+				resourcesByGroup[fw.Group] = append(resourcesByGroup[fw.Group], resource)
+			}
+		}
+	}
+	slices.Sort(groups)
+
+	rules := make([]rbacv1.PolicyRule, 0, len(groups))
+	for _, group := range groups {
+		resources := resourcesByGroup[group]
+		slices.Sort(resources)
+		rules = append(rules, rbacv1.PolicyRule{
+			APIGroups: []string{group},
+			Resources: resources,
+			Verbs:     slices.Clone(externalFrameworkVerbs),
+		})
+	}
+
+	return &rbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   ExternalFrameworksClusterRoleName,
+			Labels: defaultLabels(),
+		},
+		Rules: rules,
+	}
+}
+
+// BuildExternalFrameworksClusterRoleBinding binds the external frameworks
+// ClusterRole to the Kueue controller service account in the given namespace.
+func BuildExternalFrameworksClusterRoleBinding(namespace string) *rbacv1.ClusterRoleBinding {
+	return &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   ExternalFrameworksClusterRoleBindingName,
+			Labels: defaultLabels(),
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      "ServiceAccount",
+				Name:      kueueServiceAccountName,
+				Namespace: namespace,
+			},
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: "rbac.authorization.k8s.io",
+			Kind:     "ClusterRole",
+			Name:     ExternalFrameworksClusterRoleName,
+		},
+	}
+}
+
+func defaultLabels() map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/component": "controller",
+		"app.kubernetes.io/name":      "kueue",
+		"control-plane":               "controller-manager",
+	}
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	rbacv1 "k8s.io/api/rbac/v1"
+
+	kueue "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+)
+
+func TestMergeExternalFrameworks(t *testing.T) {
+	testCases := map[string]struct {
+		configuration kueue.KueueConfiguration
+		want          []kueue.ExternalFramework
+	}{
+		"no external frameworks": {
+			configuration: kueue.KueueConfiguration{},
+			want:          nil,
+		},
+		"integrations and multikueue are merged and deduplicated": {
+			configuration: kueue.KueueConfiguration{
+				Integrations: kueue.Integrations{
+					ExternalFrameworks: []kueue.ExternalFramework{
+						{Group: "workload.codeflare.dev", Resource: "appwrappers", Version: "v1beta2"},
+						{Group: "example.com", Resource: "myjobs", Version: "v1"},
+					},
+				},
+				MultiKueue: &kueue.MultiKueue{
+					ExternalFrameworks: []kueue.ExternalFramework{
+						{Group: "example.com", Resource: "myjobs", Version: "v1"},
+						{Group: "batch.example.com", Resource: "otherjobs", Version: "v1alpha1"},
+					},
+				},
+			},
+			want: []kueue.ExternalFramework{
+				{Group: "batch.example.com", Resource: "otherjobs", Version: "v1alpha1"},
+				{Group: "example.com", Resource: "myjobs", Version: "v1"},
+				{Group: "workload.codeflare.dev", Resource: "appwrappers", Version: "v1beta2"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := MergeExternalFrameworks(tc.configuration)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("unexpected frameworks (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildExternalFrameworksClusterRole(t *testing.T) {
+	testCases := map[string]struct {
+		frameworks []kueue.ExternalFramework
+		wantRules  []rbacv1.PolicyRule
+		wantNil    bool
+	}{
+		"no frameworks": {
+			wantNil: true,
+		},
+		"single framework": {
+			frameworks: []kueue.ExternalFramework{
+				{Group: "workload.codeflare.dev", Resource: "appwrappers", Version: "v1beta2"},
+			},
+			wantRules: []rbacv1.PolicyRule{
+				{
+					APIGroups: []string{"workload.codeflare.dev"},
+					Resources: []string{"appwrappers", "appwrappers/status"},
+					Verbs:     []string{"get", "list", "watch", "update", "patch"},
+				},
+			},
+		},
+		"resources of the same group share a rule": {
+			frameworks: []kueue.ExternalFramework{
+				{Group: "example.com", Resource: "myjobs", Version: "v1"},
+				{Group: "batch.example.com", Resource: "otherjobs", Version: "v1alpha1"},
+				{Group: "example.com", Resource: "anotherjobs", Version: "v1"},
+				{Group: "example.com", Resource: "myjobs", Version: "v2"},
+			},
+			wantRules: []rbacv1.PolicyRule{
+				{
+					APIGroups: []string{"batch.example.com"},
+					Resources: []string{"otherjobs", "otherjobs/status"},
+					Verbs:     []string{"get", "list", "watch", "update", "patch"},
+				},
+				{
+					APIGroups: []string{"example.com"},
+					Resources: []string{"anotherjobs", "anotherjobs/status", "myjobs", "myjobs/status"},
+					Verbs:     []string{"get", "list", "watch", "update", "patch"},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := BuildExternalFrameworksClusterRole(tc.frameworks)
+			if tc.wantNil {
+				if got != nil {
+					t.Fatalf("expected nil ClusterRole, got %v", got)
+				}
+				return
+			}
+			if got.Name != ExternalFrameworksClusterRoleName {
+				t.Errorf("unexpected name %q", got.Name)
+			}
+			if diff := cmp.Diff(tc.wantRules, got.Rules); diff != "" {
+				t.Errorf("unexpected rules (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildExternalFrameworksClusterRoleBinding(t *testing.T) {
+	got := BuildExternalFrameworksClusterRoleBinding("openshift-kueue-operator")
+	want := []rbacv1.Subject{
+		{Kind: "ServiceAccount", Name: "kueue-controller-manager", Namespace: "openshift-kueue-operator"},
+	}
+	if diff := cmp.Diff(want, got.Subjects); diff != "" {
+		t.Errorf("unexpected subjects (-want,+got):\n%s", diff)
+	}
+	if got.RoleRef.Name != ExternalFrameworksClusterRoleName {
+		t.Errorf("unexpected role ref %q", got.RoleRef.Name)
+	}
+}
@@ -18,6 +18,7 @@ package tlsprofile
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 
 	configv1 "github.com/openshift/api/config/v1"
@@ -69,6 +70,23 @@ func TLSOptionsFromProfile(profile *configv1.TLSSecurityProfile) (*configapi.TLS
 	return opts, nil
 }
 
+// ApplyToTLSConfig sets the minimum version and the cipher suites of the TLS options on
+// a Go TLS configuration. Cipher suites unknown to Go are skipped.
+func ApplyToTLSConfig(config *tls.Config, opts *configapi.TLSOptions) error {
+	minVersion, err := crypto.TLSVersion(opts.MinVersion)
+	if err != nil {
+		return err
+	}
+	config.MinVersion = minVersion
+	config.CipherSuites = nil
+	for _, name := range opts.CipherSuites {
+		if cipherSuite, err := crypto.CipherSuite(name); err == nil {
+			config.CipherSuites = append(config.CipherSuites, cipherSuite)
+		}
+	}
+	return nil
+}
+
 // getProfileSpec resolves a TLSSecurityProfile to its TLSProfileSpec.
 func getProfileSpec(profile *configv1.TLSSecurityProfile) (*configv1.TLSProfileSpec, error) {
 	if profile == nil {
@@ -17,9 +17,12 @@ limitations under the License.
 package tlsprofile
 
 import (
+	"crypto/tls"
+	"slices"
 	"testing"
 
 	configv1 "github.com/openshift/api/config/v1"
+	configapi "sigs.k8s.io/kueue/apis/config/v1beta2"
 )
 
 func TestTLSOptionsFromProfile(t *testing.T) {
@@ -181,3 +184,64 @@ func TestGetProfileSpec(t *testing.T) {
 		}
 	})
 }
+
+func TestApplyToTLSConfig(t *testing.T) {
+	tests := []struct {
+		name                 string
+		opts                 *configapi.TLSOptions
+		expectedMinVersion   uint16
+		expectedCipherSuites []uint16
+		expectError          bool
+	}{
+		{
+			name: "TLS 1.2 with cipher suites",
+			opts: &configapi.TLSOptions{
+				MinVersion:   "VersionTLS12",
+				CipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", "TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384"},
+			},
+			expectedMinVersion:   tls.VersionTLS12,
+			expectedCipherSuites: []uint16{tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256, tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384},
+		},
+		{
+			name:               "TLS 1.3 without cipher suites",
+			opts:               &configapi.TLSOptions{MinVersion: "VersionTLS13"},
+			expectedMinVersion: tls.VersionTLS13,
+		},
+		{
+			name: "unknown cipher suites are skipped",
+			opts: &configapi.TLSOptions{
+				MinVersion:   "VersionTLS12",
+				CipherSuites: []string{"UNKNOWN", "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"},
+			},
+			expectedMinVersion:   tls.VersionTLS12,
+			expectedCipherSuites: []uint16{tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256},
+		},
+		{
+			name:        "unknown version returns error",
+			opts:        &configapi.TLSOptions{MinVersion: "VersionTLS99"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &tls.Config{CipherSuites: []uint16{tls.TLS_RSA_WITH_AES_128_CBC_SHA}}
+			err := ApplyToTLSConfig(config, tt.opts)
+			if tt.expectError {
+				if err == nil {
+					t.Error("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if config.MinVersion != tt.expectedMinVersion {
+				t.Errorf("expected MinVersion %d, got %d", tt.expectedMinVersion, config.MinVersion)
+			}
+			if !slices.Equal(config.CipherSuites, tt.expectedCipherSuites) {
+				t.Errorf("expected CipherSuites %v, got %v", tt.expectedCipherSuites, config.CipherSuites)
+			}
+		})
+	}
+}
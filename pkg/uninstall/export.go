@@ -0,0 +1,110 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package uninstall
+
+import (
+	"fmt"
+	"strings"
+
+	kueue "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// ExportLabel is the label of the ConfigMaps of an export, set to the export name.
+	ExportLabel = "kueue.openshift.io/export"
+	// ExportUIDAnnotation is the UID of the Kueue CR the export was taken for.
+	ExportUIDAnnotation = "kueue.openshift.io/kueue-uid"
+	// ExportIndexKey is the key of the ConfigMap listing the ConfigMaps of an export.
+	ExportIndexKey = "index"
+	// ExportResourcesKey is the key of the exported resources in the ConfigMaps.
+	ExportResourcesKey = "resources.yaml"
+	// maxExportSize bounds the size of the resources written to a ConfigMap, below the
+	// 1MiB limit of ConfigMaps.
+	maxExportSize = 900 * 1024
+)
+
+// IsExported reports whether the ConfigMap listing an export was written for the Kueue
+// CR, so that the export is taken only once.
+func IsExported(index *corev1.ConfigMap, uid string) bool {
+	return index.Annotations[ExportUIDAnnotation] == uid && index.Data[ExportIndexKey] != ""
+}
+
+// ExportConfigMaps returns the ConfigMaps the objects are exported to. The ConfigMap
+// listing the others comes last, to be written once they are.
+func ExportConfigMaps(export kueue.ConfigMapExport, uid string, objects []unstructured.Unstructured) ([]*corev1.ConfigMap, error) {
+	newConfigMap := func(name string, data map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   export.Namespace,
+				Labels:      map[string]string{ExportLabel: export.Name},
+				Annotations: map[string]string{ExportUIDAnnotation: uid},
+			},
+			Data: data,
+		}
+	}
+
+	configMaps := []*corev1.ConfigMap{}
+	names := []string{}
+	var chunk strings.Builder
+	flush := func() {
+		if chunk.Len() == 0 {
+			return
+		}
+		name := fmt.Sprintf("%s-%d", export.Name, len(configMaps))
+		configMaps = append(configMaps, newConfigMap(name, map[string]string{ExportResourcesKey: chunk.String()}))
+		names = append(names, name)
+		chunk.Reset()
+	}
+	for _, obj := range objects {
+		document, err := yaml.Marshal(sanitize(obj).Object)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal %s %s/%s: %w", obj.GetKind(), obj.GetNamespace(), obj.GetName(), err)
+		}
+		if len(document) > maxExportSize {
+			return nil, fmt.Errorf("%s %s/%s is too large to be exported to a ConfigMap", obj.GetKind(), obj.GetNamespace(), obj.GetName())
+		}
+		if chunk.Len()+len(document)+len("---\n") > maxExportSize {
+			flush()
+		}
+		chunk.WriteString("---\n")
+		chunk.Write(document)
+	}
+	flush()
+
+	index := strings.Join(names, "\n")
+	if index == "" {
+		// An empty export is still recorded as complete.
+		index = "-"
+	}
+	return append(configMaps, newConfigMap(export.Name, map[string]string{ExportIndexKey: index})), nil
+}
+
+// sanitize returns a copy of the object without its status and the metadata the API
+// server sets, so that it can be created again.
+func sanitize(obj unstructured.Unstructured) *unstructured.Unstructured {
+	sanitized := obj.DeepCopy()
+	unstructured.RemoveNestedField(sanitized.Object, "status")
+	for _, field := range []string{"managedFields", "uid", "resourceVersion", "generation", "creationTimestamp", "deletionTimestamp", "deletionGracePeriodSeconds", "ownerReferences", "finalizers"} {
+		unstructured.RemoveNestedField(sanitized.Object, "metadata", field)
+	}
+	return sanitized
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package uninstall
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	kueue "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+)
+
+func clusterQueue(name, description string) unstructured.Unstructured {
+	return unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "kueue.x-k8s.io/v1beta2",
+		"kind":       "ClusterQueue",
+		"metadata": map[string]interface{}{
+			"name":            name,
+			"uid":             "1",
+			"resourceVersion": "42",
+			"finalizers":      []interface{}{"kueue.x-k8s.io/resource-in-use"},
+			"annotations":     map[string]interface{}{"description": description},
+		},
+		"spec":   map[string]interface{}{"cohortName": "all"},
+		"status": map[string]interface{}{"pendingWorkloads": int64(3)},
+	}}
+}
+
+func TestExportConfigMaps(t *testing.T) {
+	export := kueue.ConfigMapExport{Namespace: "backup", Name: "kueue"}
+
+	t.Run("single ConfigMap", func(t *testing.T) {
+		got, err := ExportConfigMaps(export, "uid", []unstructured.Unstructured{clusterQueue("team-a", "")})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != 2 {
+			t.Fatalf("got %d ConfigMaps, want 2", len(got))
+		}
+		want := `---
+apiVersion: kueue.x-k8s.io/v1beta2
+kind: ClusterQueue
+metadata:
+  annotations:
+    description: ""
+  name: team-a
+spec:
+  cohortName: all
+`
+		if diff := cmp.Diff(want, got[0].Data[ExportResourcesKey]); diff != "" {
+			t.Errorf("unexpected export (-want,+got):\n%s", diff)
+		}
+		if got[0].Name != "kueue-0" || got[0].Namespace != "backup" || got[0].Labels[ExportLabel] != "kueue" {
+			t.Errorf("unexpected metadata: %v", got[0].ObjectMeta)
+		}
+		if got[1].Name != "kueue" || !IsExported(got[1], "uid") || IsExported(got[1], "other") {
+			t.Errorf("unexpected index: %v", got[1])
+		}
+		if diff := cmp.Diff("kueue-0", got[1].Data[ExportIndexKey]); diff != "" {
+			t.Errorf("unexpected index (-want,+got):\n%s", diff)
+		}
+	})
+
+	t.Run("split export", func(t *testing.T) {
+		objects := []unstructured.Unstructured{}
+		for i := range 5 {
+			objects = append(objects, clusterQueue(fmt.Sprintf("team-%d", i), strings.Repeat("x", 300*1024)))
+		}
+		got, err := ExportConfigMaps(export, "uid", objects)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff("kueue-0\nkueue-1\nkueue-2", got[len(got)-1].Data[ExportIndexKey]); diff != "" {
+			t.Errorf("unexpected index (-want,+got):\n%s", diff)
+		}
+		for _, configMap := range got {
+			if size := len(configMap.Data[ExportResourcesKey]); size > maxExportSize {
+				t.Errorf("ConfigMap %s holds %d bytes", configMap.Name, size)
+			}
+		}
+	})
+
+	t.Run("empty export", func(t *testing.T) {
+		got, err := ExportConfigMaps(export, "uid", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != 1 || !IsExported(got[0], "uid") {
+			t.Errorf("unexpected export: %v", got)
+		}
+	})
+
+	t.Run("object too large", func(t *testing.T) {
+		_, err := ExportConfigMaps(export, "uid", []unstructured.Unstructured{clusterQueue("team-a", strings.Repeat("x", maxExportSize))})
+		if err == nil {
+			t.Errorf("expected an error")
+		}
+	})
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package uninstall
+
+import (
+	"fmt"
+
+	kueue "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Counts are the workloads uninstalling Kueue would affect.
+type Counts struct {
+	Admitted int64
+	Pending  int64
+}
+
+// CountWorkloads sums the admitted and pending workloads of the LocalQueues.
+func CountWorkloads(localQueues []unstructured.Unstructured) Counts {
+	counts := Counts{}
+	for _, localQueue := range localQueues {
+		admitted, _, _ := unstructured.NestedInt64(localQueue.Object, "status", "admittedWorkloads")
+		pending, _, _ := unstructured.NestedInt64(localQueue.Object, "status", "pendingWorkloads")
+		counts.Admitted += admitted
+		counts.Pending += pending
+	}
+	return counts
+}
+
+// PreflightMessage describes what uninstalling Kueue would do with the workloads, given
+// the uninstall and removal policies of the spec.
+func PreflightMessage(spec kueue.KueueOperandSpec, counts Counts) string {
+	message := fmt.Sprintf("%d admitted and %d pending workloads", counts.Admitted, counts.Pending)
+	switch spec.UninstallPolicy {
+	case kueue.UninstallPolicyReleaseAll:
+		message += "; on uninstall, the pending workloads are released without quota"
+	case kueue.UninstallPolicyBlock:
+		message += "; on uninstall, Kueue is removed once no workload is pending"
+	default:
+		message += "; on uninstall, the pending workloads stay suspended until Kueue is installed again"
+	}
+	if spec.RemovalPolicy == kueue.RemovalPolicyDelete {
+		message += ", and the Kueue CRDs, queues and Workloads are deleted"
+	} else {
+		message += ", and the Kueue CRDs, queues and Workloads are kept"
+	}
+	if export := spec.UninstallExport.ConfigMap; export.Name != "" {
+		message += fmt.Sprintf(" after being exported to ConfigMap %s/%s", export.Namespace, export.Name)
+	}
+	return message
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package uninstall
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	kueue "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+)
+
+func TestCountWorkloads(t *testing.T) {
+	localQueue := func(admitted, pending int64) unstructured.Unstructured {
+		return unstructured.Unstructured{Object: map[string]interface{}{
+			"status": map[string]interface{}{"admittedWorkloads": admitted, "pendingWorkloads": pending},
+		}}
+	}
+	got := CountWorkloads([]unstructured.Unstructured{localQueue(2, 1), localQueue(0, 4), {Object: map[string]interface{}{}}})
+	if diff := cmp.Diff(Counts{Admitted: 2, Pending: 5}, got); diff != "" {
+		t.Errorf("unexpected counts (-want,+got):\n%s", diff)
+	}
+}
+
+func TestPreflightMessage(t *testing.T) {
+	testCases := map[string]struct {
+		spec kueue.KueueOperandSpec
+		want string
+	}{
+		"defaults": {
+			want: "2 admitted and 1 pending workloads; on uninstall, the pending workloads stay suspended until Kueue is installed again, and the Kueue CRDs, queues and Workloads are kept",
+		},
+		"release and delete": {
+			spec: kueue.KueueOperandSpec{
+				UninstallPolicy: kueue.UninstallPolicyReleaseAll,
+				RemovalPolicy:   kueue.RemovalPolicyDelete,
+				UninstallExport: kueue.UninstallExport{ConfigMap: kueue.ConfigMapExport{Namespace: "backup", Name: "kueue"}},
+			},
+			want: "2 admitted and 1 pending workloads; on uninstall, the pending workloads are released without quota, and the Kueue CRDs, queues and Workloads are deleted after being exported to ConfigMap backup/kueue",
+		},
+		"block": {
+			spec: kueue.KueueOperandSpec{UninstallPolicy: kueue.UninstallPolicyBlock},
+			want: "2 admitted and 1 pending workloads; on uninstall, Kueue is removed once no workload is pending, and the Kueue CRDs, queues and Workloads are kept",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, PreflightMessage(tc.spec, Counts{Admitted: 2, Pending: 1})); diff != "" {
+				t.Errorf("unexpected message (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package uninstall finds the workloads left waiting for admission when Kueue is
+// uninstalled, builds the patches releasing them, and exports the Kueue custom resources
+// before they are deleted.
+package uninstall
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	kueuev1beta2 "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+)
+
+const (
+	// AdmissionGate is the scheduling gate Kueue adds to the pods it manages until they
+	// are admitted.
+	AdmissionGate = "kueue.x-k8s.io/admission"
+	// ManagedLabel is the label Kueue sets on the pods it manages.
+	ManagedLabel = "kueue.x-k8s.io/managed"
+	// maxReported bounds the number of objects listed in messages.
+	maxReported = 10
+)
+
+// WorkloadsGVR is the resource of the Kueue Workloads.
+var WorkloadsGVR = kueuev1beta2.GroupVersion.WithResource("workloads")
+
+// ManagedPodsSelector selects the pods managed by Kueue.
+func ManagedPodsSelector() string {
+	return ManagedLabel + "=true"
+}
+
+// IsGated reports whether the pod waits for admission by Kueue.
+func IsGated(pod *corev1.Pod) bool {
+	return slices.ContainsFunc(pod.Spec.SchedulingGates, func(gate corev1.PodSchedulingGate) bool {
+		return gate.Name == AdmissionGate
+	})
+}
+
+// RemoveGatePatch returns the strategic merge patch removing the admission gate from a
+// pod, leaving the other scheduling gates in place.
+func RemoveGatePatch() []byte {
+	return []byte(fmt.Sprintf(`{"spec":{"schedulingGates":[{"$patch":"delete","name":%q}]}}`, AdmissionGate))
+}
+
+// IsPending reports whether the workload waits for admission: it is neither admitted
+// nor finished.
+func IsPending(workload unstructured.Unstructured) bool {
+	conditions, _, _ := unstructured.NestedSlice(workload.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok || condition["status"] != "True" {
+			continue
+		}
+		if condition["type"] == kueuev1beta2.WorkloadAdmitted || condition["type"] == kueuev1beta2.WorkloadFinished {
+			return false
+		}
+	}
+	return true
+}
+
+// Summary describes the objects still waiting for admission, listing at most
+// maxReported of them.
+func Summary(workloads, pods []string) string {
+	describe := func(kind string, names []string) string {
+		reported := names
+		if len(reported) > maxReported {
+			reported = append(reported[:maxReported:maxReported], fmt.Sprintf("and %d more", len(names)-maxReported))
+		}
+		return fmt.Sprintf("%d %s (%s)", len(names), kind, strings.Join(reported, ", "))
+	}
+	parts := []string{}
+	if len(workloads) > 0 {
+		parts = append(parts, describe("workloads", workloads))
+	}
+	if len(pods) > 0 {
+		parts = append(parts, describe("gated pods", pods))
+	}
+	return strings.Join(parts, " and ")
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package uninstall
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func workload(conditions []interface{}) unstructured.Unstructured {
+	return unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":      "job-sample",
+			"namespace": "team-a",
+		},
+		"status": map[string]interface{}{"conditions": conditions},
+	}}
+}
+
+func condition(conditionType, status string) interface{} {
+	return map[string]interface{}{"type": conditionType, "status": status}
+}
+
+func TestIsPending(t *testing.T) {
+	testCases := map[string]struct {
+		conditions []interface{}
+		want       bool
+	}{
+		"no condition": {want: true},
+		"quota reserved": {
+			conditions: []interface{}{condition("QuotaReserved", "True"), condition("Admitted", "False")},
+			want:       true,
+		},
+		"admitted": {
+			conditions: []interface{}{condition("QuotaReserved", "True"), condition("Admitted", "True")},
+		},
+		"finished": {
+			conditions: []interface{}{condition("Finished", "True")},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsPending(workload(tc.conditions)); got != tc.want {
+				t.Errorf("IsPending() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsGated(t *testing.T) {
+	pod := &corev1.Pod{Spec: corev1.PodSpec{SchedulingGates: []corev1.PodSchedulingGate{{Name: "example.com/gate"}}}}
+	if IsGated(pod) {
+		t.Errorf("IsGated() = true for a pod without the admission gate")
+	}
+	pod.Spec.SchedulingGates = append(pod.Spec.SchedulingGates, corev1.PodSchedulingGate{Name: AdmissionGate})
+	if !IsGated(pod) {
+		t.Errorf("IsGated() = false for a pod with the admission gate")
+	}
+}
+
+func TestSummary(t *testing.T) {
+	pods := []string{}
+	for _, name := range []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l"} {
+		pods = append(pods, "team-a/"+name)
+	}
+	got := Summary([]string{"team-b/job-sample"}, pods)
+	want := "1 workloads (team-b/job-sample) and 12 gated pods (team-a/a, team-a/b, team-a/c, team-a/d, team-a/e, team-a/f, team-a/g, team-a/h, team-a/i, team-a/j, and 2 more)"
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected summary (-want,+got):\n%s", diff)
+	}
+}
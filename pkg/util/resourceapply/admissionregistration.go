@@ -0,0 +1,86 @@
+package resourceapply
+
+import (
+	"context"
+
+	"github.com/openshift/library-go/pkg/operator/events"
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	admissionregistrationclientv1beta1 "k8s.io/client-go/kubernetes/typed/admissionregistration/v1beta1"
+
+	"github.com/openshift/library-go/pkg/operator/resource/resourceapply"
+	"github.com/openshift/library-go/pkg/operator/resource/resourcehelper"
+	"github.com/openshift/library-go/pkg/operator/resource/resourcemerge"
+
+	"k8s.io/klog/v2"
+)
+
+// ApplyMutatingAdmissionPolicyV1beta1 applies the MutatingAdmissionPolicy object
+// specified in want to the cluster. diff is true if the object on the cluster was
+// updated.
+// TODO: move to library-go
+func ApplyMutatingAdmissionPolicyV1beta1(ctx context.Context, getter admissionregistrationclientv1beta1.MutatingAdmissionPoliciesGetter, recorder events.Recorder, want *admissionregistrationv1beta1.MutatingAdmissionPolicy) (current *admissionregistrationv1beta1.MutatingAdmissionPolicy, diff bool, err error) {
+	client := getter.MutatingAdmissionPolicies()
+	current, err = client.Get(ctx, want.Name, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, false, err
+		}
+
+		copy := want.DeepCopy()
+		current, err := client.Create(ctx, resourcemerge.WithCleanLabelsAndAnnotations(copy).(*admissionregistrationv1beta1.MutatingAdmissionPolicy), metav1.CreateOptions{})
+		resourcehelper.ReportCreateEvent(recorder, want, err)
+		return current, false, err
+	}
+
+	copy := current.DeepCopy()
+	resourcemerge.EnsureObjectMeta(&diff, &copy.ObjectMeta, want.ObjectMeta)
+	if !diff && equality.Semantic.DeepEqual(current.Spec, want.Spec) {
+		return current, false, nil
+	}
+
+	copy.Spec = *want.Spec.DeepCopy()
+	if klog.V(2).Enabled() {
+		klog.Infof("MutatingAdmissionPolicy %q changes: %v", want.Name, resourceapply.JSONPatchNoError(current, copy))
+	}
+
+	current, err = client.Update(ctx, copy, metav1.UpdateOptions{})
+	resourcehelper.ReportUpdateEvent(recorder, want, err)
+	return current, true, err
+}
+
+// ApplyMutatingAdmissionPolicyBindingV1beta1 applies the MutatingAdmissionPolicyBinding
+// object specified in want to the cluster. diff is true if the object on the cluster
+// was updated.
+// TODO: move to library-go
+func ApplyMutatingAdmissionPolicyBindingV1beta1(ctx context.Context, getter admissionregistrationclientv1beta1.MutatingAdmissionPolicyBindingsGetter, recorder events.Recorder, want *admissionregistrationv1beta1.MutatingAdmissionPolicyBinding) (current *admissionregistrationv1beta1.MutatingAdmissionPolicyBinding, diff bool, err error) {
+	client := getter.MutatingAdmissionPolicyBindings()
+	current, err = client.Get(ctx, want.Name, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, false, err
+		}
+
+		copy := want.DeepCopy()
+		current, err := client.Create(ctx, resourcemerge.WithCleanLabelsAndAnnotations(copy).(*admissionregistrationv1beta1.MutatingAdmissionPolicyBinding), metav1.CreateOptions{})
+		resourcehelper.ReportCreateEvent(recorder, want, err)
+		return current, false, err
+	}
+
+	copy := current.DeepCopy()
+	resourcemerge.EnsureObjectMeta(&diff, &copy.ObjectMeta, want.ObjectMeta)
+	if !diff && equality.Semantic.DeepEqual(current.Spec, want.Spec) {
+		return current, false, nil
+	}
+
+	copy.Spec = *want.Spec.DeepCopy()
+	if klog.V(2).Enabled() {
+		klog.Infof("MutatingAdmissionPolicyBinding %q changes: %v", want.Name, resourceapply.JSONPatchNoError(current, copy))
+	}
+
+	current, err = client.Update(ctx, copy, metav1.UpdateOptions{})
+	resourcehelper.ReportUpdateEvent(recorder, want, err)
+	return current, true, err
+}
@@ -0,0 +1,103 @@
+package resourceapply
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	ktesting "k8s.io/client-go/testing"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+
+	"github.com/openshift/library-go/pkg/operator/events"
+)
+
+func TestApplyMutatingAdmissionPolicy(t *testing.T) {
+	newObject := func() *admissionregistrationv1beta1.MutatingAdmissionPolicy {
+		return &admissionregistrationv1beta1.MutatingAdmissionPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "foo",
+			},
+			Spec: admissionregistrationv1beta1.MutatingAdmissionPolicySpec{
+				FailurePolicy:      ptr.To(admissionregistrationv1beta1.Ignore),
+				ReinvocationPolicy: admissionregistrationv1beta1.NeverReinvocationPolicy,
+			},
+		}
+	}
+
+	tests := []struct {
+		name string
+		// current represents what exists on the cluster
+		// desired represents the desired object passed to ApplyMutatingAdmissionPolicyV1beta1
+		setup func() (current, desired *admissionregistrationv1beta1.MutatingAdmissionPolicy)
+
+		// expectation
+		operations []string
+		diff       bool
+	}{
+		{
+			name: "object does not exist on cluster, should be created",
+			setup: func() (current, desired *admissionregistrationv1beta1.MutatingAdmissionPolicy) {
+				return nil, newObject()
+			},
+			diff:       false,
+			operations: []string{"get", "create"},
+		},
+		{
+			name: "object exists on cluster, no change in spec desired, should not call update",
+			setup: func() (current, desired *admissionregistrationv1beta1.MutatingAdmissionPolicy) {
+				return newObject(), newObject()
+			},
+			diff:       false,
+			operations: []string{"get"},
+		},
+		{
+			name: "object exists on cluster, desired spec changes, should call update",
+			setup: func() (current, desired *admissionregistrationv1beta1.MutatingAdmissionPolicy) {
+				current, desired = newObject(), newObject()
+				desired.Spec.FailurePolicy = ptr.To(admissionregistrationv1beta1.Fail)
+				return current, desired
+			},
+			diff:       true,
+			operations: []string{"get", "update"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			current, desired := test.setup()
+
+			exists := []runtime.Object{}
+			if current != nil {
+				exists = append(exists, current)
+			}
+			client := fake.NewClientset(exists...)
+			operationsObserved := make([]string, 0)
+			client.PrependReactor("*", "*", func(action ktesting.Action) (bool, runtime.Object, error) {
+				operationsObserved = append(operationsObserved, action.GetVerb())
+				return false, nil, nil
+			})
+
+			recorder := events.NewInMemoryRecorder("test", clocktesting.NewFakePassiveClock(time.Now()))
+			currentGot, diff, err := ApplyMutatingAdmissionPolicyV1beta1(context.Background(), client.AdmissionregistrationV1beta1(), recorder, desired)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if want, got := desired.Spec, currentGot.Spec; !cmp.Equal(want, got) {
+				t.Errorf("expected spec to be equal, diff: %s", cmp.Diff(want, got))
+			}
+			if want, got := test.diff, diff; want != got {
+				t.Errorf("expected modified to be: %t, but got: %t", want, got)
+			}
+			if want, got := test.operations, operationsObserved; !cmp.Equal(want, got) {
+				t.Errorf("operations do not match, diff: %s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
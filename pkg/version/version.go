@@ -1,6 +1,8 @@
 package version
 
 import (
+	"runtime/debug"
+
 	"github.com/prometheus/client_golang/prometheus"
 
 	"k8s.io/apimachinery/pkg/version"
@@ -33,6 +35,26 @@ func Get() version.Info {
 	}
 }
 
+// KueueVersion returns the version of the Kueue module the operator is built with, which
+// is the Kueue release it deploys, or an empty string when the build information is not
+// available.
+func KueueVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+	for _, dep := range info.Deps {
+		if dep.Path != "sigs.k8s.io/kueue" {
+			continue
+		}
+		if dep.Replace != nil {
+			return dep.Replace.Version
+		}
+		return dep.Version
+	}
+	return ""
+}
+
 func init() {
 	buildInfo := prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -23,13 +23,12 @@ import (
 	"strings"
 
 	kueue "github.com/openshift/kueue-operator/pkg/apis/kueueoperator/v1"
+	"github.com/openshift/kueue-operator/pkg/integration"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 const (
-	// kueueManagedLabel is the label used to opt-in resources for kueue.
-	kueueManagedLabel        = "kueue.openshift.io/managed"
 	annotationWorkload       = "kueue.x-k8s.io/workload"
 	annotationClusterQueue   = "kueue.x-k8s.io/clusterqueue"
 	annotationResourceFlavor = "kueue.x-k8s.io/resourceflavor"
@@ -229,7 +228,7 @@ func defaultLabelSelector() *metav1.LabelSelector {
 	return &metav1.LabelSelector{
 		MatchExpressions: []metav1.LabelSelectorRequirement{
 			{
-				Key:      kueueManagedLabel,
+				Key:      kueue.ManagedNamespaceLabel,
 				Operator: metav1.LabelSelectorOpIn,
 				Values:   []string{"true"},
 			},
@@ -237,22 +236,13 @@ func defaultLabelSelector() *metav1.LabelSelector {
 	}
 }
 
-// buildEnabledFrameworks creates a map of enabled frameworks from the kueue configuration,
-// and implicitly enables the Pod integration when LeaderWorkerSet, StatefulSet, or Deployment
-// are enabled (since they require pod scheduling gates).
+// buildEnabledFrameworks creates a map of the frameworks enabled in Kueue, including the
+// Pod integration implicitly enabled by LeaderWorkerSet, StatefulSet, or Deployment
+// (since they require pod scheduling gates).
 func buildEnabledFrameworks(kueueCfg kueue.KueueConfiguration) map[string]bool {
 	enabledFrameworks := make(map[string]bool)
-	for _, fw := range kueueCfg.Integrations.Frameworks {
+	for _, fw := range integration.Enabled(kueueCfg.Integrations.Frameworks) {
 		enabledFrameworks[string(fw)] = true
 	}
-
-	// Pod webhook is required for LeaderWorkerSet, StatefulSet, and Deployment
-	// to properly add scheduling gates to pods
-	if enabledFrameworks[string(kueue.KueueIntegrationLeaderWorkerSet)] ||
-		enabledFrameworks[string(kueue.KueueIntegrationStatefulSet)] ||
-		enabledFrameworks[string(kueue.KueueIntegrationDeployment)] {
-		enabledFrameworks[string(kueue.KueueIntegrationPod)] = true
-	}
-
 	return enabledFrameworks
 }